@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -20,6 +21,9 @@ type Env struct {
 	AccessTokenExpiry  time.Duration
 	RefreshTokenSecret string
 	RefreshTokenExpiry time.Duration
+	AdminEmails        []string
+	BlobStorageDir     string
+	BlobBaseURL        string
 }
 
 func NewEnv() *Env {
@@ -34,6 +38,9 @@ func NewEnv() *Env {
 	env.AccessTokenExpiry = env.getDuration("ACCESS_TOKEN_EXPIRY", 15*time.Minute)
 	env.RefreshTokenSecret = os.Getenv("REFRESH_TOKEN_SECRET")
 	env.RefreshTokenExpiry = env.getDuration("REFRESH_TOKEN_EXPIRY", 24*time.Hour)
+	env.AdminEmails = env.getList("ADMIN_EMAILS")
+	env.BlobStorageDir = env.getString("BLOB_STORAGE_DIR", "uploads")
+	env.BlobBaseURL = env.getString("BLOB_BASE_URL", "/user/me/files")
 
 	// Debug logging
 	log.Printf("Environment variables loaded:")
@@ -43,6 +50,8 @@ func NewEnv() *Env {
 	log.Printf("GEMINI_API_KEY: %s", env.GeminiAPIKey)
 	log.Printf("ACCESS_TOKEN_SECRET: %s", env.AccessTokenSecret)
 	log.Printf("REFRESH_TOKEN_SECRET: %s", env.RefreshTokenSecret)
+	log.Printf("ADMIN_EMAILS: %v", env.AdminEmails)
+	log.Printf("BLOB_STORAGE_DIR: %s", env.BlobStorageDir)
 
 	return &env
 }
@@ -88,3 +97,20 @@ func (e *Env) getDuration(key string, defaultValue time.Duration) time.Duration
 
 	return time.Duration(value) * time.Second
 }
+
+func (e *Env) getString(key string, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func (e *Env) getList(key string) []string {
+	values := []string{}
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type CoachReviewController struct {
+	CoachReviewUsecase domain.CoachReviewUsecase
+}
+
+// respondCoachReviewError maps annotation and score override errors to their status codes
+func respondCoachReviewError(c *gin.Context, err error) {
+	message := err.Error()
+	status := http.StatusInternalServerError
+	switch {
+	case strings.HasSuffix(message, "not found"):
+		status = http.StatusNotFound
+	case message == "only coaches can do this", message == "you can only change your own annotations":
+		status = http.StatusForbidden
+	case message == "room is not completed yet":
+		status = http.StatusConflict
+	case strings.HasPrefix(message, "invalid "), strings.HasSuffix(message, "is required"), message == "a reason is required to override a score",
+		message == "score must be between 0 and 100", message == "replies go to the thread's first annotation":
+		status = http.StatusBadRequest
+	}
+	c.IndentedJSON(status, config.ResponseData{Error: true, ErrorMessage: message, SuccessResponse: false})
+}
+
+func (uc *CoachReviewController) GetAnnotations(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	threads, err := uc.CoachReviewUsecase.GetAnnotations(c, userID, c.Param("id"))
+	if err != nil {
+		respondCoachReviewError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: threads})
+}
+
+func (uc *CoachReviewController) CreateAnnotation(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var request domain.AnnotationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	annotation, err := uc.CoachReviewUsecase.CreateAnnotation(c, userID, c.Param("id"), request)
+	if err != nil {
+		respondCoachReviewError(c, err)
+		return
+	}
+
+	successMessage := "Annotation created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: annotation})
+}
+
+func (uc *CoachReviewController) UpdateAnnotation(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var request domain.AnnotationUpdateRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	annotation, err := uc.CoachReviewUsecase.UpdateAnnotation(c, userID, c.Param("id"), c.Param("annotation_id"), request.Text)
+	if err != nil {
+		respondCoachReviewError(c, err)
+		return
+	}
+
+	successMessage := "Annotation updated successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: annotation})
+}
+
+func (uc *CoachReviewController) DeleteAnnotation(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := uc.CoachReviewUsecase.DeleteAnnotation(c, userID, c.Param("id"), c.Param("annotation_id")); err != nil {
+		respondCoachReviewError(c, err)
+		return
+	}
+
+	successMessage := "Annotation deleted successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage})
+}
+
+func (uc *CoachReviewController) OverrideScore(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var request domain.ScoreOverrideRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	room, err := uc.CoachReviewUsecase.OverrideScore(c, userID, c.Param("id"), c.Param("feedback_id"), request)
+	if err != nil {
+		respondCoachReviewError(c, err)
+		return
+	}
+
+	successMessage := "Score overridden successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: room})
+}
+
+func (uc *CoachReviewController) RemoveScoreOverride(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	room, err := uc.CoachReviewUsecase.RemoveScoreOverride(c, userID, c.Param("id"), c.Param("feedback_id"))
+	if err != nil {
+		respondCoachReviewError(c, err)
+		return
+	}
+
+	successMessage := "Score override removed successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: room})
+}
+
+func (uc *CoachReviewController) GetScoreOverrides(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	overrides, err := uc.CoachReviewUsecase.GetScoreOverrides(c, userID, c.Param("id"))
+	if err != nil {
+		respondCoachReviewError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: overrides})
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type ExportController struct {
+	ExportUsecase domain.ExportUsecase
+}
+
+// respondExportError maps export errors to their status codes
+func respondExportError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "room not found":
+		c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+	case "format must be json, markdown, html or pdf":
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+	default:
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+	}
+}
+
+// download sends an export as a file attachment
+func download(c *gin.Context, file domain.ExportFile) {
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Filename))
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
+
+// ExportRoom downloads a room in the format of ?format=, JSON by default
+func (uc *ExportController) ExportRoom(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	file, err := uc.ExportUsecase.ExportRoom(c, userID, c.Param("id"), c.DefaultQuery("format", domain.ExportFormatJSON))
+	if err != nil {
+		respondExportError(c, err)
+		return
+	}
+	download(c, file)
+}
+
+// ExportRooms downloads all of the user's rooms in one file
+func (uc *ExportController) ExportRooms(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	file, err := uc.ExportUsecase.ExportRooms(c, userID, c.DefaultQuery("format", domain.ExportFormatJSON))
+	if err != nil {
+		respondExportError(c, err)
+		return
+	}
+	download(c, file)
+}
+
+// GetExportSchema returns the JSON Schema of JSON exports
+func (uc *ExportController) GetExportSchema(c *gin.Context) {
+	c.Data(http.StatusOK, "application/schema+json", []byte(infrastructure.ExportJSONSchema))
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type FileController struct {
+	BlobStore domain.BlobStore
+}
+
+// GetFile streams a blob; the route must declare a *key wildcard.
+// Blob keys are laid out as "<kind>/<owner user ID>/...", so users can only read their own files.
+func (uc *FileController) GetFile(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	// The owner check runs on the canonical key, so "..", "%2e%2e" and the like cannot reach other users' files
+	key, err := uc.BlobStore.Key(c.Param("key"))
+	if err != nil {
+		c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: "file not found", SuccessResponse: false})
+		return
+	}
+	segments := strings.Split(key, "/")
+	if len(segments) < 3 || segments[1] != userID.Hex() {
+		c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: "file not found", SuccessResponse: false})
+		return
+	}
+
+	reader, contentType, err := uc.BlobStore.Get(c, key)
+	if err != nil {
+		if err.Error() == "blob not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: "file not found", SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+	defer reader.Close()
+
+	c.Header("Content-Type", contentType)
+	c.Status(http.StatusOK)
+	_, _ = io.Copy(c.Writer, reader)
+}
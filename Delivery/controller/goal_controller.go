@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type GoalController struct {
+	GoalUsecase domain.GoalUsecase
+}
+
+func (uc *GoalController) CreateGoal(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var goal domain.Goal
+	if err := c.ShouldBindJSON(&goal); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+	goal.UserID = userID
+
+	goalResponse, err := uc.GoalUsecase.CreateGoal(c, goal)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "failed to") {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	successMessage := "Goal created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: goalResponse})
+}
+
+func (uc *GoalController) GetGoals(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	goals, err := uc.GoalUsecase.GetGoals(c, userID)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: goals})
+}
+
+func (uc *GoalController) DeleteGoal(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := uc.GoalUsecase.DeleteGoal(c, userID, c.Param("id")); err != nil {
+		switch {
+		case err.Error() == "goal not found":
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		case strings.HasPrefix(err.Error(), "invalid goal ID"):
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		default:
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	successMessage := "Goal deleted successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage})
+}
+
+// GetGoalProgress summarises goal progress, the practice streak and badges
+func (uc *GoalController) GetGoalProgress(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	summary, err := uc.GoalUsecase.EvaluateGoals(c, userID)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: summary})
+}
+
+// GetGoalEvents lists the latest goal and badge events, newest first
+func (uc *GoalController) GetGoalEvents(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	events, err := uc.GoalUsecase.GetGoalEvents(c, userID)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: events})
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type InterviewTemplateController struct {
+	InterviewTemplateUsecase domain.InterviewTemplateUsecase
+}
+
+func (uc *InterviewTemplateController) CreateTemplate(c *gin.Context) {
+	var template domain.InterviewTemplate
+	if err := c.ShouldBindJSON(&template); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	templateResponse, err := uc.InterviewTemplateUsecase.CreateTemplate(c, template)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	successMessage := "Interview template created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: templateResponse})
+}
+
+func (uc *InterviewTemplateController) GetTemplates(c *gin.Context) {
+	templates, err := uc.InterviewTemplateUsecase.GetTemplates(c)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: templates})
+}
+
+// GetTemplate returns the latest version of a type, or a specific one when ?version= is set
+func (uc *InterviewTemplateController) GetTemplate(c *gin.Context) {
+	interviewType := c.Param("type")
+
+	var template domain.InterviewTemplate
+	var err error
+	if versionStr := c.Query("version"); versionStr != "" {
+		version, convErr := strconv.Atoi(versionStr)
+		if convErr != nil {
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: "invalid version", SuccessResponse: false})
+			return
+		}
+		template, err = uc.InterviewTemplateUsecase.GetTemplateVersion(c, interviewType, version)
+	} else {
+		template, err = uc.InterviewTemplateUsecase.GetLatestTemplate(c, interviewType)
+	}
+
+	if err != nil {
+		if err.Error() == "interview template not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: template})
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"net/http"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type MessageAudioController struct {
+	MessageAudioUsecase domain.MessageAudioUsecase
+}
+
+// GetMessageAudio returns the audio URL of an interviewer message, generating it on first request
+func (uc *MessageAudioController) GetMessageAudio(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	audioURL, err := uc.MessageAudioUsecase.GetMessageAudio(c, userID, c.Param("id"), c.Param("message_id"))
+	if err != nil {
+		switch err.Error() {
+		case "room not found", "message not found":
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		case "only interviewer messages can be spoken":
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		default:
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: gin.H{"audio_url": audioURL}})
+}
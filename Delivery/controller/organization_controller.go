@@ -0,0 +1,238 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type OrganizationController struct {
+	OrganizationUsecase domain.OrganizationUsecase
+}
+
+// respondOrganizationError maps organization errors to their status codes
+func respondOrganizationError(c *gin.Context, err error) {
+	message := err.Error()
+	status := http.StatusInternalServerError
+	switch {
+	case strings.HasSuffix(message, "not found"):
+		status = http.StatusNotFound
+	case message == "your role in this organization does not allow this", message == "coaches can only invite candidates", message == "only candidates' rooms can be reviewed":
+		status = http.StatusForbidden
+	case message == "user is already a member", message == "an organization needs at least one owner":
+		status = http.StatusConflict
+	case message == "invitation has expired":
+		status = http.StatusGone
+	case strings.HasPrefix(message, "invalid "), strings.HasPrefix(message, "role must be"), message == "a valid email is required", message == "organization name is required":
+		status = http.StatusBadRequest
+	}
+	c.IndentedJSON(status, config.ResponseData{Error: true, ErrorMessage: message, SuccessResponse: false})
+}
+
+func (uc *OrganizationController) CreateOrganization(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var request domain.OrganizationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	organization, err := uc.OrganizationUsecase.CreateOrganization(c, userID, request.Name)
+	if err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	successMessage := "Organization created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: organization})
+}
+
+func (uc *OrganizationController) GetOrganizations(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	organizations, err := uc.OrganizationUsecase.GetOrganizations(c, userID)
+	if err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: organizations})
+}
+
+func (uc *OrganizationController) GetMembers(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	members, err := uc.OrganizationUsecase.GetMembers(c, userID, c.Param("id"))
+	if err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: members})
+}
+
+func (uc *OrganizationController) UpdateMemberRole(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var request domain.MemberRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	member, err := uc.OrganizationUsecase.UpdateMemberRole(c, userID, c.Param("id"), c.Param("user_id"), request.Role)
+	if err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	successMessage := "Member role updated successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: member})
+}
+
+func (uc *OrganizationController) RemoveMember(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := uc.OrganizationUsecase.RemoveMember(c, userID, c.Param("id"), c.Param("user_id")); err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	successMessage := "Member removed successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage})
+}
+
+func (uc *OrganizationController) CreateInvitation(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var request domain.InvitationRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	invitation, err := uc.OrganizationUsecase.CreateInvitation(c, userID, c.Param("id"), request)
+	if err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	successMessage := "Invitation created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: invitation})
+}
+
+func (uc *OrganizationController) GetOrganizationInvitations(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	invitations, err := uc.OrganizationUsecase.GetOrganizationInvitations(c, userID, c.Param("id"))
+	if err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: invitations})
+}
+
+func (uc *OrganizationController) RevokeInvitation(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := uc.OrganizationUsecase.RevokeInvitation(c, userID, c.Param("id"), c.Param("invitation_id")); err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	successMessage := "Invitation revoked successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage})
+}
+
+// GetMyInvitations lists the pending invitations sent to the user's email
+func (uc *OrganizationController) GetMyInvitations(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	invitations, err := uc.OrganizationUsecase.GetMyInvitations(c, userID)
+	if err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: invitations})
+}
+
+func (uc *OrganizationController) AcceptInvitation(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	member, err := uc.OrganizationUsecase.AcceptInvitation(c, userID, c.Param("id"))
+	if err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	successMessage := "Invitation accepted successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: member})
+}
+
+// GetMemberRooms lists a candidate's rooms for the organization's coaches
+func (uc *OrganizationController) GetMemberRooms(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	rooms, err := uc.OrganizationUsecase.GetMemberRooms(c, userID, c.Param("id"), c.Param("user_id"))
+	if err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: rooms})
+}
+
+// GetMemberRoom returns one of a candidate's rooms, with its feedback, for the organization's coaches
+func (uc *OrganizationController) GetMemberRoom(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	room, err := uc.OrganizationUsecase.GetMemberRoom(c, userID, c.Param("id"), c.Param("user_id"), c.Param("room_id"))
+	if err != nil {
+		respondOrganizationError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: room})
+}
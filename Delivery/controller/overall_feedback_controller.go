@@ -14,14 +14,18 @@ type OverallFeedbackController struct {
 }
 
 func (c *OverallFeedbackController) CreateOverallFeedback(ctx *gin.Context) {
-	var overallFeedback domain.OverallFeedback
-	if err := ctx.ShouldBindJSON(&overallFeedback); err != nil {
-		ctx.JSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+	// The feedback is always for the signed-in user; the request body is not used
+	currentUser, ok := currentUserID(ctx)
+	if !ok {
 		return
 	}
 
-	err := c.OverallFeedbackUsecase.CreateOverallFeedback(ctx, overallFeedback)
+	err := c.OverallFeedbackUsecase.CreateOverallFeedback(ctx, domain.OverallFeedback{UserID: currentUser})
 	if err != nil {
+		if err.Error() == "overall feedback is already up to date" {
+			ctx.JSON(http.StatusConflict, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
 		return
 	}
@@ -38,6 +42,15 @@ func (c *OverallFeedbackController) GetOverallFeedback(ctx *gin.Context) {
 		return
 	}
 
+	currentUser, ok := currentUserID(ctx)
+	if !ok {
+		return
+	}
+	if userID != currentUser {
+		ctx.JSON(http.StatusForbidden, config.ResponseData{Error: true, ErrorMessage: "you can only read your own overall feedback", SuccessResponse: false})
+		return
+	}
+
 	overallFeedback, err := c.OverallFeedbackUsecase.GetOverallFeedback(ctx, userID)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"net/http"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type PersonaController struct {
+	PersonaUsecase domain.PersonaUsecase
+}
+
+func (uc *PersonaController) CreatePersona(c *gin.Context) {
+	var persona domain.Persona
+	if err := c.ShouldBindJSON(&persona); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	personaResponse, err := uc.PersonaUsecase.CreatePersona(c, persona)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	successMessage := "Persona created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: personaResponse})
+}
+
+func (uc *PersonaController) GetPersonas(c *gin.Context) {
+	personas, err := uc.PersonaUsecase.GetPersonas(c)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: personas})
+}
+
+func (uc *PersonaController) GetPersona(c *gin.Context) {
+	persona, err := uc.PersonaUsecase.GetPersona(c, c.Param("key"))
+	if err != nil {
+		if err.Error() == "persona not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: persona})
+}
+
+func (uc *PersonaController) UpdatePersona(c *gin.Context) {
+	var persona domain.Persona
+	if err := c.ShouldBindJSON(&persona); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	personaResponse, err := uc.PersonaUsecase.UpdatePersona(c, c.Param("key"), persona)
+	if err != nil {
+		if err.Error() == "persona not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	successMessage := "Persona updated successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: personaResponse})
+}
+
+func (uc *PersonaController) DeletePersona(c *gin.Context) {
+	err := uc.PersonaUsecase.DeletePersona(c, c.Param("key"))
+	if err != nil {
+		if err.Error() == "persona not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else if err.Error() == "persona is used by existing rooms" {
+			c.IndentedJSON(http.StatusConflict, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	successMessage := "Persona deleted successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage})
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type ProgressController struct {
+	ProgressUsecase domain.ProgressUsecase
+}
+
+// GetProgress returns the user's score trends; ?weeks= sets the period and ?window= the moving average
+func (uc *ProgressController) GetProgress(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var query domain.ProgressQuery
+	for name, target := range map[string]*int{"weeks": &query.Weeks, "window": &query.Window} {
+		value := c.Query(name)
+		if value == "" {
+			continue
+		}
+		number, err := strconv.Atoi(value)
+		if err != nil || number <= 0 {
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: "invalid " + name, SuccessResponse: false})
+			return
+		}
+		*target = number
+	}
+
+	report, err := uc.ProgressUsecase.GetProgress(c, userID, query)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: report})
+}
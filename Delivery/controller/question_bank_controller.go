@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type QuestionBankController struct {
+	QuestionBankUsecase domain.QuestionBankUsecase
+}
+
+func (uc *QuestionBankController) CreateQuestion(c *gin.Context) {
+	var question domain.Question
+	if err := c.ShouldBindJSON(&question); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	questionResponse, err := uc.QuestionBankUsecase.CreateQuestion(c, question)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	successMessage := "Question created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: questionResponse})
+}
+
+func (uc *QuestionBankController) GetQuestion(c *gin.Context) {
+	question, err := uc.QuestionBankUsecase.GetQuestion(c, c.Param("id"))
+	if err != nil {
+		if err.Error() == "question not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: question})
+}
+
+// GetQuestions lists bank questions filtered by ?role=, ?topic=, ?difficulty= and ?tags=a,b
+func (uc *QuestionBankController) GetQuestions(c *gin.Context) {
+	filter := domain.QuestionFilter{
+		Role:       c.Query("role"),
+		Topic:      c.Query("topic"),
+		Difficulty: c.Query("difficulty"),
+	}
+	if tags := c.Query("tags"); tags != "" {
+		filter.Tags = strings.Split(tags, ",")
+	}
+
+	questions, err := uc.QuestionBankUsecase.GetQuestions(c, filter)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: questions})
+}
+
+func (uc *QuestionBankController) UpdateQuestion(c *gin.Context) {
+	var question domain.Question
+	if err := c.ShouldBindJSON(&question); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	questionResponse, err := uc.QuestionBankUsecase.UpdateQuestion(c, c.Param("id"), question)
+	if err != nil {
+		if err.Error() == "question not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	successMessage := "Question updated successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: questionResponse})
+}
+
+func (uc *QuestionBankController) DeleteQuestion(c *gin.Context) {
+	err := uc.QuestionBankUsecase.DeleteQuestion(c, c.Param("id"))
+	if err != nil {
+		if err.Error() == "question not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	successMessage := "Question deleted successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage})
+}
+
+// ImportQuestions bulk imports questions from a JSON array or a CSV file.
+// The payload is either a multipart "file" field (format taken from the extension)
+// or the raw request body (format taken from the Content-Type header).
+func (uc *QuestionBankController) ImportQuestions(c *gin.Context) {
+	var reader io.Reader
+	var format string
+
+	if file, err := c.FormFile("file"); err == nil {
+		opened, err := file.Open()
+		if err != nil {
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+			return
+		}
+		defer opened.Close()
+		reader = opened
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(file.Filename)), ".")
+	} else {
+		reader = c.Request.Body
+		if strings.Contains(c.ContentType(), "csv") {
+			format = "csv"
+		} else {
+			format = "json"
+		}
+	}
+
+	var questions []domain.Question
+	var err error
+	switch format {
+	case "csv":
+		questions, err = infrastructure.ParseQuestionsCSV(reader)
+	case "json":
+		questions, err = infrastructure.ParseQuestionsJSON(reader)
+	default:
+		err = fmt.Errorf("unsupported import format %q, use json or csv", format)
+	}
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	imported, err := uc.QuestionBankUsecase.ImportQuestions(c, questions)
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	successMessage := fmt.Sprintf("%d questions imported successfully", imported)
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage})
+}
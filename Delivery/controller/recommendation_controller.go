@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type RecommendationController struct {
+	RecommendationUsecase domain.RecommendationUsecase
+}
+
+// respondRecommendationError maps recommendation errors to their status codes
+func respondRecommendationError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch err.Error() {
+	case "practice plan not found", "recommendation not found":
+		status = http.StatusNotFound
+	case "complete an interview to get recommendations":
+		status = http.StatusUnprocessableEntity
+	case "recommendation was already started":
+		status = http.StatusConflict
+	case "role and topic are required":
+		status = http.StatusBadRequest
+	}
+	c.IndentedJSON(status, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+}
+
+// GetRecommendations proposes the next rooms to practice; ?limit= sets how many
+func (uc *RecommendationController) GetRecommendations(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	limit := 0
+	if value := c.Query("limit"); value != "" {
+		number, err := strconv.Atoi(value)
+		if err != nil || number <= 0 {
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: "invalid limit", SuccessResponse: false})
+			return
+		}
+		limit = number
+	}
+
+	recommendations, err := uc.RecommendationUsecase.GetRecommendations(c, userID, limit)
+	if err != nil {
+		respondRecommendationError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: recommendations})
+}
+
+// CreatePracticePlan replaces the user's practice plan; the body is optional
+func (uc *RecommendationController) CreatePracticePlan(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var request domain.PracticePlanRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+			return
+		}
+	}
+
+	plan, err := uc.RecommendationUsecase.CreatePracticePlan(c, userID, request)
+	if err != nil {
+		respondRecommendationError(c, err)
+		return
+	}
+
+	successMessage := "Practice plan created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: plan})
+}
+
+func (uc *RecommendationController) GetPracticePlan(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	plan, err := uc.RecommendationUsecase.GetPracticePlan(c, userID)
+	if err != nil {
+		respondRecommendationError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: plan})
+}
+
+// StartRecommendation creates a room from a recommendation. Sending only the ID of a
+// practice plan recommendation starts that item of the plan.
+func (uc *RecommendationController) StartRecommendation(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var recommendation domain.Recommendation
+	if err := c.ShouldBindJSON(&recommendation); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	room, err := uc.RecommendationUsecase.StartRecommendation(c, userID, recommendation)
+	if err != nil {
+		respondRecommendationError(c, err)
+		return
+	}
+
+	successMessage := "Room created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: room})
+}
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+// maxResumeSize is the largest CV accepted by UploadResume
+const maxResumeSize = 10 << 20
+
+type ResumeController struct {
+	ResumeUsecase domain.ResumeUsecase
+}
+
+// UploadResume accepts a CV as the multipart "file" field
+func (uc *ResumeController) UploadResume(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: "resume file is required", SuccessResponse: false})
+		return
+	}
+	if file.Size > maxResumeSize {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: fmt.Sprintf("resume must be smaller than %d MB", maxResumeSize>>20), SuccessResponse: false})
+		return
+	}
+
+	opened, err := file.Open()
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+	defer opened.Close()
+
+	content, err := io.ReadAll(io.LimitReader(opened, maxResumeSize))
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	profile, err := uc.ResumeUsecase.UploadResume(c, userID, domain.ResumeUpload{
+		Filename:    file.Filename,
+		ContentType: file.Header.Get("Content-Type"),
+		Content:     content,
+	})
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	successMessage := "Resume uploaded successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: profile})
+}
+
+func (uc *ResumeController) GetCandidateProfile(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	profile, err := uc.ResumeUsecase.GetCandidateProfile(c, userID)
+	if err != nil {
+		if err.Error() == "candidate profile not found" || err.Error() == "user not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: profile})
+}
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"net/http"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewController struct {
+	ReviewUsecase domain.ReviewUsecase
+}
+
+// GetReviewItems lists the user's spaced repetition schedule; ?due=true keeps only due items
+func (uc *ReviewController) GetReviewItems(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	items, err := uc.ReviewUsecase.GetReviewItems(c, userID, c.Query("due") == "true")
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: items})
+}
+
+// CreateReviewSession starts a review room with the questions that are due; the body is optional
+func (uc *ReviewController) CreateReviewSession(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var request domain.ReviewSessionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+			return
+		}
+	}
+
+	room, err := uc.ReviewUsecase.CreateReviewSession(c, userID, request.Count)
+	if err != nil {
+		if err.Error() == "no questions are due for review" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	successMessage := "Review session created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: room})
+}
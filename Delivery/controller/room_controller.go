@@ -2,6 +2,7 @@ package controller
 
 import (
 	"net/http"
+	"time"
 
 	domain "github.com/chachidani/interview-coach-backend/Domain"
 	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
@@ -14,11 +15,27 @@ type RoomController struct {
 }
 
 func (uc *RoomController) CreateRoom(c *gin.Context) {
-	var room domain.Room
-	if err := c.ShouldBindJSON(&room); err != nil {
+	var request domain.CreateRoomRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
 		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
 		return
 	}
+	// Review rooms are planned by the review scheduler, see POST /reviews/session
+	if request.Mode != domain.RoomModeImprovised && request.Mode != domain.RoomModeQuestionBank {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: "mode must be empty or question_bank", SuccessResponse: false})
+		return
+	}
+	room := domain.Room{
+		Role:           request.Role,
+		Topic:          request.Topic,
+		InterviewType:  request.InterviewType,
+		Mode:           request.Mode,
+		Difficulty:     request.Difficulty,
+		Personas:       request.Personas,
+		Focus:          request.Focus,
+		JobDescription: request.JobDescription,
+		AutoSpeech:     request.AutoSpeech,
+	}
 
 	// Get user ID from JWT token context
 	userID, exists := c.Get("userID")
@@ -43,7 +60,8 @@ func (uc *RoomController) CreateRoom(c *gin.Context) {
 		return
 	}
 
-	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &roomResponse})
+	successMessage := "Room created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: roomResponse})
 }
 
 func (uc *RoomController) GetRoom(c *gin.Context) {
@@ -53,7 +71,13 @@ func (uc *RoomController) GetRoom(c *gin.Context) {
 		return
 	}
 
-	roomResponse, err := uc.RoomUsecase.GetRoom(c, roomID)
+	// Users only read their own rooms here; coaches go through their organization
+	currentUser, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	roomResponse, err := uc.RoomUsecase.GetUserRoom(c, currentUser, roomID)
 	if err != nil {
 		if err.Error() == "room not found" {
 			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
@@ -84,6 +108,15 @@ func (uc *RoomController) GetRoomsWithUserID(c *gin.Context) {
 		return
 	}
 
+	currentUser, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	if objectID != currentUser {
+		c.IndentedJSON(http.StatusForbidden, config.ResponseData{Error: true, ErrorMessage: "you can only list your own rooms", SuccessResponse: false})
+		return
+	}
+
 	rooms, err := uc.RoomUsecase.GetRoomsWithUserID(c, objectID)
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
@@ -99,14 +132,23 @@ func (uc *RoomController) GetRoomsWithUserID(c *gin.Context) {
 
 func (uc *RoomController) UpdateRoom(c *gin.Context) {
 	roomID := c.Param("id")
-	var room domain.Room
-	if err := c.ShouldBindJSON(&room); err != nil {
+	var request domain.UpdateRoomRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
 		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
 		return
 	}
 
-	roomResponse, err := uc.RoomUsecase.UpdateRoom(c, roomID, room)
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	roomResponse, err := uc.RoomUsecase.UpdateRoom(c, userID, roomID, request)
 	if err != nil {
+		if err.Error() == "room not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+			return
+		}
 		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
 		return
 	}
@@ -117,26 +159,27 @@ func (uc *RoomController) UpdateRoom(c *gin.Context) {
 
 func (uc *RoomController) CompletedRoom(c *gin.Context) {
 	roomID := c.Param("id")
-	userID := c.Param("user_id")
 
 	if roomID == "" {
 		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: "room ID is required", SuccessResponse: false})
 		return
 	}
 
-	if userID == "" {
-		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: "user ID is required", SuccessResponse: false})
+	userID, ok := currentUserID(c)
+	if !ok {
 		return
 	}
 
-	objectID, err := primitive.ObjectIDFromHex(userID)
-	if err != nil {
-		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
-		return
-	}
-
-	roomResponse, err := uc.RoomUsecase.CompletedRoom(c, objectID, roomID)
+	roomResponse, err := uc.RoomUsecase.CompletedRoom(c, userID, roomID)
 	if err != nil {
+		if err.Error() == "room not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+			return
+		}
+		if err.Error() == "room is being completed" {
+			c.IndentedJSON(http.StatusConflict, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+			return
+		}
 		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
 		return
 	}
@@ -147,8 +190,18 @@ func (uc *RoomController) CompletedRoom(c *gin.Context) {
 
 func (uc *RoomController) DeleteRoom(c *gin.Context) {
 	roomID := c.Param("id")
-	err := uc.RoomUsecase.DeleteRoom(c, roomID)
+
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	err := uc.RoomUsecase.DeleteRoom(c, userID, roomID)
 	if err != nil {
+		if err.Error() == "room not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+			return
+		}
 		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
 		return
 	}
@@ -159,23 +212,190 @@ func (uc *RoomController) DeleteRoom(c *gin.Context) {
 
 func (uc *RoomController) AddMessageToRoom(c *gin.Context) {
 	roomID := c.Param("id")
-	var message domain.Message
-	if err := c.ShouldBindJSON(&message); err != nil {
+	var request domain.MessageRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
 		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
 		return
 	}
 
-	roomResponse, err := uc.RoomUsecase.AddMessageToRoom(c, roomID, message)
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	roomResponse, err := uc.RoomUsecase.AddMessageToRoom(c, userID, roomID, domain.Message{
+		Sender:    "user",
+		Text:      request.Text,
+		Timestamp: time.Now().Unix(),
+	})
+	respondRoomRevision(c, roomResponse, err, "Message added to room successfully")
+}
+
+// respondRoomRevision writes the result of an action on the current exchange of a room
+func respondRoomRevision(c *gin.Context, room domain.Room, err error, successMessage string) {
 	if err != nil {
+		switch err.Error() {
+		case "room not found":
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		case "room is already completed", "room is being completed", "the last message is not from the interviewer", "the opening message cannot be regenerated",
+			"there is no answer to edit", "there is no answer to retract", "answer text is required", "there is no open question",
+			"the room changed while it was being updated, try again":
+			c.IndentedJSON(http.StatusConflict, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		default:
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: room})
+}
+
+// RegenerateLastMessage replaces the interviewer's last message with a new one
+func (uc *RoomController) RegenerateLastMessage(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	room, err := uc.RoomUsecase.RegenerateLastMessage(c, userID, c.Param("id"))
+	respondRoomRevision(c, room, err, "Message regenerated successfully")
+}
+
+// EditLastAnswer changes the text of the user's last answer
+func (uc *RoomController) EditLastAnswer(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var request domain.EditAnswerRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	room, err := uc.RoomUsecase.EditLastAnswer(c, userID, c.Param("id"), request.Text)
+	respondRoomRevision(c, room, err, "Answer edited successfully")
+}
+
+// RetractLastAnswer withdraws the user's last answer so the question can be answered again
+func (uc *RoomController) RetractLastAnswer(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	room, err := uc.RoomUsecase.RetractLastAnswer(c, userID, c.Param("id"))
+	respondRoomRevision(c, room, err, "Answer retracted successfully")
+}
+
+// RequestHint adds a hint on the current question
+func (uc *RoomController) RequestHint(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	room, err := uc.RoomUsecase.RequestAssistance(c, userID, c.Param("id"), domain.MessageTypeHint)
+	respondRoomRevision(c, room, err, "Hint added successfully")
+}
+
+// RequestModelAnswer adds a strong answer to the current question
+func (uc *RoomController) RequestModelAnswer(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	room, err := uc.RoomUsecase.RequestAssistance(c, userID, c.Param("id"), domain.MessageTypeModelAnswer)
+	respondRoomRevision(c, room, err, "Model answer added successfully")
+}
+
+// respondFeedbackRunError writes the error of a feedback run request
+func respondFeedbackRunError(c *gin.Context, err error) {
+	switch err.Error() {
+	case "room not found", "feedback run not found":
+		c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+	case "room is not completed yet":
+		c.IndentedJSON(http.StatusConflict, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+	case "invalid model name":
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+	default:
 		c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+	}
+}
+
+// ReevaluateRoom grades a completed room again as a new feedback run
+func (uc *RoomController) ReevaluateRoom(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
 		return
 	}
-	successMessage := "Message added to room successfully"
 
-	c.IndentedJSON(http.StatusOK, config.ResponseData{
-		Error:           false,
-		SuccessResponse: true,
-		SuccessMessage:  &successMessage,
-		Data:            roomResponse,
-	})
+	// The body is optional; an empty one grades with the default model
+	var request domain.ReevaluateRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+			return
+		}
+	}
+
+	run, err := uc.RoomUsecase.ReevaluateRoom(c, userID, c.Param("id"), request.Model)
+	if err != nil {
+		respondFeedbackRunError(c, err)
+		return
+	}
+
+	successMessage := "Room re-evaluated successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: run})
+}
+
+// GetFeedbackRuns lists the feedback runs of a room, oldest first
+func (uc *RoomController) GetFeedbackRuns(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	runs, err := uc.RoomUsecase.GetFeedbackRuns(c, userID, c.Param("id"))
+	if err != nil {
+		respondFeedbackRunError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: runs})
+}
+
+// GetFeedbackRun returns a feedback run of a room with its feedback
+func (uc *RoomController) GetFeedbackRun(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	run, err := uc.RoomUsecase.GetFeedbackRun(c, userID, c.Param("id"), c.Param("run_id"))
+	if err != nil {
+		respondFeedbackRunError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: run})
+}
+
+// SetCanonicalRun makes a feedback run the one the room shows
+func (uc *RoomController) SetCanonicalRun(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	room, err := uc.RoomUsecase.SetCanonicalRun(c, userID, c.Param("id"), c.Param("run_id"))
+	if err != nil {
+		respondFeedbackRunError(c, err)
+		return
+	}
+
+	successMessage := "Canonical feedback run updated successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: room})
 }
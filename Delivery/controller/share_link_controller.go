@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type ShareLinkController struct {
+	ShareLinkUsecase domain.ShareLinkUsecase
+}
+
+// respondShareLinkError maps share link errors to their status codes
+func respondShareLinkError(c *gin.Context, err error) {
+	message := err.Error()
+	status := http.StatusInternalServerError
+	switch {
+	case strings.HasSuffix(message, "not found"):
+		status = http.StatusNotFound
+	case message == "share link is no longer available":
+		status = http.StatusGone
+	case message == "only completed rooms can be shared":
+		status = http.StatusConflict
+	case strings.HasPrefix(message, "invalid "), strings.HasPrefix(message, "expires_in_hours must be"):
+		status = http.StatusBadRequest
+	}
+	c.IndentedJSON(status, config.ResponseData{Error: true, ErrorMessage: message, SuccessResponse: false})
+}
+
+func (uc *ShareLinkController) CreateShareLink(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var request domain.ShareLinkRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&request); err != nil {
+			c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+			return
+		}
+	}
+
+	link, err := uc.ShareLinkUsecase.CreateShareLink(c, userID, c.Param("id"), request)
+	if err != nil {
+		respondShareLinkError(c, err)
+		return
+	}
+
+	successMessage := "Share link created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: link})
+}
+
+func (uc *ShareLinkController) GetShareLinks(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	links, err := uc.ShareLinkUsecase.GetShareLinks(c, userID, c.Param("id"))
+	if err != nil {
+		respondShareLinkError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: links})
+}
+
+func (uc *ShareLinkController) RevokeShareLink(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := uc.ShareLinkUsecase.RevokeShareLink(c, userID, c.Param("id"), c.Param("link_id")); err != nil {
+		respondShareLinkError(c, err)
+		return
+	}
+
+	successMessage := "Share link revoked successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage})
+}
+
+// GetSharedReport serves the report of a share link without authentication: an HTML
+// page by default, or JSON with ?format=json
+func (uc *ShareLinkController) GetSharedReport(c *gin.Context) {
+	// The token is the permission, so keep it out of caches, search engines and referrers
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Robots-Tag", "noindex")
+	c.Header("Referrer-Policy", "no-referrer")
+
+	report, err := uc.ShareLinkUsecase.GetSharedReport(c, c.Param("token"))
+	if err != nil {
+		respondShareLinkError(c, err)
+		return
+	}
+
+	if c.Query("format") == "json" {
+		c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: report})
+		return
+	}
+
+	page, err := infrastructure.RenderReportHTML(report)
+	if err != nil {
+		respondShareLinkError(c, err)
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", page)
+}
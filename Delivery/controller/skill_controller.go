@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+type SkillController struct {
+	SkillUsecase domain.SkillUsecase
+}
+
+// respondSkillError maps taxonomy errors to their status codes
+func respondSkillError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case err.Error() == "skill not found":
+		status = http.StatusNotFound
+	case strings.HasSuffix(err.Error(), "already exists"), err.Error() == "skill has child skills", err.Error() == "built-in skills cannot be deleted":
+		status = http.StatusConflict
+	case strings.HasPrefix(err.Error(), "skill "), strings.HasPrefix(err.Error(), "parent skill"), err.Error() == "a skill cannot be its own ancestor":
+		status = http.StatusBadRequest
+	}
+	c.IndentedJSON(status, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+}
+
+func (uc *SkillController) CreateSkill(c *gin.Context) {
+	var skill domain.Skill
+	if err := c.ShouldBindJSON(&skill); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	skillResponse, err := uc.SkillUsecase.CreateSkill(c, skill)
+	if err != nil {
+		respondSkillError(c, err)
+		return
+	}
+
+	successMessage := "Skill created successfully"
+	c.IndentedJSON(http.StatusCreated, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: skillResponse})
+}
+
+func (uc *SkillController) GetSkills(c *gin.Context) {
+	skills, err := uc.SkillUsecase.GetSkills(c)
+	if err != nil {
+		respondSkillError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: skills})
+}
+
+func (uc *SkillController) GetSkill(c *gin.Context) {
+	skill, err := uc.SkillUsecase.GetSkill(c, c.Param("key"))
+	if err != nil {
+		respondSkillError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: skill})
+}
+
+func (uc *SkillController) UpdateSkill(c *gin.Context) {
+	var skill domain.Skill
+	if err := c.ShouldBindJSON(&skill); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	skillResponse, err := uc.SkillUsecase.UpdateSkill(c, c.Param("key"), skill)
+	if err != nil {
+		respondSkillError(c, err)
+		return
+	}
+
+	successMessage := "Skill updated successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: skillResponse})
+}
+
+func (uc *SkillController) DeleteSkill(c *gin.Context) {
+	if err := uc.SkillUsecase.DeleteSkill(c, c.Param("key")); err != nil {
+		respondSkillError(c, err)
+		return
+	}
+
+	successMessage := "Skill deleted successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage})
+}
+
+// RetagAll tags bank questions and rooms again after the taxonomy changed
+func (uc *SkillController) RetagAll(c *gin.Context) {
+	result, err := uc.SkillUsecase.RetagAll(c)
+	if err != nil {
+		respondSkillError(c, err)
+		return
+	}
+
+	successMessage := "Skills retagged successfully"
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: result})
+}
+
+// GetCompetencyMap returns the user's average score per skill of the taxonomy
+func (uc *SkillController) GetCompetencyMap(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	competencies, err := uc.SkillUsecase.GetCompetencyMap(c, userID)
+	if err != nil {
+		respondSkillError(c, err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: competencies})
+}
@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// currentUserID reads the authenticated user's ID set by AuthMiddleware.
+// It writes the error response itself and returns false when the ID is missing or invalid.
+func currentUserID(c *gin.Context) (primitive.ObjectID, bool) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.IndentedJSON(http.StatusUnauthorized, config.ResponseData{Error: true, ErrorMessage: "User ID not found in token", SuccessResponse: false})
+		return primitive.NilObjectID, false
+	}
+
+	userIDStr, _ := userID.(string)
+	objectID, err := primitive.ObjectIDFromHex(userIDStr)
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: "Invalid user ID format", SuccessResponse: false})
+		return primitive.NilObjectID, false
+	}
+	return objectID, true
+}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/config"
+	"github.com/gin-gonic/gin"
+)
+
+// maxAudioSize is the largest recording accepted by SubmitVoiceAnswer
+const maxAudioSize = 25 << 20
+
+type VoiceAnswerController struct {
+	VoiceAnswerUsecase domain.VoiceAnswerUsecase
+}
+
+// SubmitVoiceAnswer accepts a recorded answer as the multipart "audio" field.
+// Processing is asynchronous; poll GetVoiceAnswer for the status.
+func (uc *VoiceAnswerController) SubmitVoiceAnswer(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	file, err := c.FormFile("audio")
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: "audio file is required", SuccessResponse: false})
+		return
+	}
+	if file.Size > maxAudioSize {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: fmt.Sprintf("audio must be smaller than %d MB", maxAudioSize>>20), SuccessResponse: false})
+		return
+	}
+
+	opened, err := file.Open()
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+	defer opened.Close()
+
+	content, err := io.ReadAll(io.LimitReader(opened, maxAudioSize))
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		return
+	}
+
+	voiceAnswer, err := uc.VoiceAnswerUsecase.SubmitVoiceAnswer(c, userID, c.Param("id"), domain.AudioUpload{
+		Filename:    file.Filename,
+		ContentType: file.Header.Get("Content-Type"),
+		Content:     content,
+	})
+	if err != nil {
+		if err.Error() == "room not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else if err.Error() == "room is already completed" || err.Error() == "room is being completed" {
+			c.IndentedJSON(http.StatusConflict, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	successMessage := "Voice answer accepted for processing"
+	c.IndentedJSON(http.StatusAccepted, config.ResponseData{Error: false, SuccessResponse: true, SuccessMessage: &successMessage, Data: voiceAnswer})
+}
+
+func (uc *VoiceAnswerController) GetVoiceAnswer(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	voiceAnswer, err := uc.VoiceAnswerUsecase.GetVoiceAnswer(c, userID, c.Param("id"))
+	if err != nil {
+		if err.Error() == "voice answer not found" {
+			c.IndentedJSON(http.StatusNotFound, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		} else {
+			c.IndentedJSON(http.StatusInternalServerError, config.ResponseData{Error: true, ErrorMessage: err.Error(), SuccessResponse: false})
+		}
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, config.ResponseData{Error: false, SuccessResponse: true, Data: voiceAnswer})
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/chachidani/interview-coach-backend/Delivery/controller"
 	domain "github.com/chachidani/interview-coach-backend/Domain"
 	"github.com/chachidani/interview-coach-backend/Infrastructure/middleware"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/speech"
+	"github.com/chachidani/interview-coach-backend/Infrastructure/storage"
 	repository "github.com/chachidani/interview-coach-backend/Repository"
 	usecases "github.com/chachidani/interview-coach-backend/Usecases"
 	"github.com/gin-gonic/gin"
@@ -17,9 +19,18 @@ func Setup(env *bootstrap.Env, timeout time.Duration, db mongo.Database, r *gin.
 	// Initialize services
 	jwtService := middleware.NewJWTService(env.AccessTokenSecret)
 	middleware.SetJWTService(jwtService)
+	middleware.SetAdminEmails(env.AdminEmails)
 	passwordService := middleware.NewPasswordService()
 	geminiRepository := repository.NewGeminiRepository()
-	roomRepository := repository.NewRoomRepository(db, domain.CollectionRoom, geminiRepository)
+	blobStore := storage.NewLocalBlobStore(env.BlobStorageDir, env.BlobBaseURL)
+	interviewTemplateRepository := repository.NewInterviewTemplateRepository(db, domain.CollectionInterviewTemplate)
+	skillRepository := repository.NewSkillRepository(db, domain.CollectionSkill, geminiRepository)
+	goalRepository := repository.NewGoalRepository(db, domain.CollectionGoal)
+	organizationRepository := repository.NewOrganizationRepository(db, domain.CollectionOrganization)
+	questionBankRepository := repository.NewQuestionBankRepository(db, domain.CollectionQuestionBank, skillRepository)
+	personaRepository := repository.NewPersonaRepository(db, domain.CollectionPersona)
+	messageAudioRepository := repository.NewMessageAudioRepository(db, domain.CollectionRoom, blobStore, speech.NewLocalTextToSpeech())
+	roomRepository := repository.NewRoomRepository(db, domain.CollectionRoom, geminiRepository, interviewTemplateRepository, questionBankRepository, personaRepository, messageAudioRepository, skillRepository, goalRepository)
 
 	publicRouter := r.Group("/api/v1")
 	NewSignUpRoutes(publicRouter, env, timeout, db, passwordService)
@@ -27,9 +38,28 @@ func Setup(env *bootstrap.Env, timeout time.Duration, db mongo.Database, r *gin.
 
 	protectedRouter := r.Group("/user/me")
 	protectedRouter.Use(middleware.AuthMiddleware())
-	NewRoomRoutes(protectedRouter, env, timeout, db, geminiRepository)
+	NewRoomRoutes(protectedRouter, env, timeout, roomRepository)
+	NewMessageAudioRoutes(protectedRouter, env, timeout, messageAudioRepository)
+	NewResumeRoutes(protectedRouter, env, timeout, db, blobStore, geminiRepository)
+	NewFileRoutes(protectedRouter, blobStore)
+	NewVoiceAnswerRoutes(protectedRouter, env, timeout, db, blobStore, speech.NewLocalSpeechToText(), roomRepository)
 
 	NewOverallFeedbackRoutes(protectedRouter, env, timeout, &db, geminiRepository, roomRepository)
+	NewProgressRoutes(protectedRouter, env, timeout, db)
+	NewRecommendationRoutes(protectedRouter, env, timeout, db, roomRepository)
+	NewReviewRoutes(protectedRouter, env, timeout, db, roomRepository)
+	NewGoalRoutes(protectedRouter, env, timeout, goalRepository)
+	NewOrganizationRoutes(protectedRouter, env, timeout, organizationRepository)
+	NewCoachReviewRoutes(protectedRouter, env, timeout, db, organizationRepository)
+	NewShareLinkRoutes(publicRouter, protectedRouter, env, timeout, db, roomRepository)
+	NewExportRoutes(protectedRouter, env, timeout, db, roomRepository)
+
+	adminRouter := protectedRouter.Group("/admin")
+	adminRouter.Use(middleware.AdminMiddleware())
+	NewQuestionBankRoutes(adminRouter, env, timeout, questionBankRepository)
+	NewInterviewTemplateRoutes(protectedRouter, adminRouter, env, timeout, interviewTemplateRepository)
+	NewPersonaRoutes(protectedRouter, adminRouter, env, timeout, personaRepository)
+	NewSkillRoutes(protectedRouter, adminRouter, env, timeout, skillRepository)
 }
 
 func NewSignUpRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db mongo.Database, passwordService *middleware.PasswordService) {
@@ -49,10 +79,9 @@ func NewLoginRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Du
 	router.POST("/login", lc.Login)
 }
 
-func NewRoomRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db mongo.Database, geminiRepository domain.GeminiRepository) {
-	rr := repository.NewRoomRepository(db, domain.CollectionRoom, geminiRepository)
+func NewRoomRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, roomRepository domain.RoomRepository) {
 	rc := &controller.RoomController{
-		RoomUsecase: usecases.NewRoomUsecase(rr, timeout),
+		RoomUsecase: usecases.NewRoomUsecase(roomRepository, timeout),
 	}
 	router.POST("/rooms", rc.CreateRoom)
 	router.GET("/rooms/:id", rc.GetRoom)
@@ -60,6 +89,184 @@ func NewRoomRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Dur
 	router.PUT("/rooms/:id", rc.UpdateRoom)
 	router.DELETE("/rooms/:id", rc.DeleteRoom)
 	router.POST("/rooms/:id/messages", rc.AddMessageToRoom)
+	router.POST("/rooms/:id/messages/regenerate", rc.RegenerateLastMessage)
+	router.PUT("/rooms/:id/messages/last", rc.EditLastAnswer)
+	router.DELETE("/rooms/:id/messages/last", rc.RetractLastAnswer)
+	router.POST("/rooms/:id/hint", rc.RequestHint)
+	router.POST("/rooms/:id/model-answer", rc.RequestModelAnswer)
+	router.POST("/rooms/:id/complete", rc.CompletedRoom)
+	router.POST("/rooms/:id/feedback-runs", rc.ReevaluateRoom)
+	router.GET("/rooms/:id/feedback-runs", rc.GetFeedbackRuns)
+	router.GET("/rooms/:id/feedback-runs/:run_id", rc.GetFeedbackRun)
+	router.PUT("/rooms/:id/feedback-runs/:run_id/canonical", rc.SetCanonicalRun)
+}
+
+// NewInterviewTemplateRoutes lets every user read templates while only admins publish new versions
+func NewInterviewTemplateRoutes(router *gin.RouterGroup, adminRouter *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, interviewTemplateRepository domain.InterviewTemplateRepository) {
+	tc := &controller.InterviewTemplateController{
+		InterviewTemplateUsecase: usecases.NewInterviewTemplateUsecase(interviewTemplateRepository, timeout),
+	}
+	adminRouter.POST("/interview-templates", tc.CreateTemplate)
+	router.GET("/interview-templates", tc.GetTemplates)
+	router.GET("/interview-templates/:type", tc.GetTemplate)
+}
+
+func NewQuestionBankRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, questionBankRepository domain.QuestionBankRepository) {
+	qc := &controller.QuestionBankController{
+		QuestionBankUsecase: usecases.NewQuestionBankUsecase(questionBankRepository, timeout),
+	}
+	router.POST("/questions", qc.CreateQuestion)
+	router.POST("/questions/import", qc.ImportQuestions)
+	router.GET("/questions", qc.GetQuestions)
+	router.GET("/questions/:id", qc.GetQuestion)
+	router.PUT("/questions/:id", qc.UpdateQuestion)
+	router.DELETE("/questions/:id", qc.DeleteQuestion)
+}
+
+func NewResumeRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db mongo.Database, blobStore domain.BlobStore, geminiRepository domain.GeminiRepository) {
+	rr := repository.NewResumeRepository(db, domain.CollectionUser, blobStore, geminiRepository)
+	rc := &controller.ResumeController{
+		ResumeUsecase: usecases.NewResumeUsecase(rr, timeout),
+	}
+	router.POST("/resume", rc.UploadResume)
+	router.GET("/resume/profile", rc.GetCandidateProfile)
+}
+
+func NewFileRoutes(router *gin.RouterGroup, blobStore domain.BlobStore) {
+	fc := &controller.FileController{
+		BlobStore: blobStore,
+	}
+	router.GET("/files/*key", fc.GetFile)
+}
+
+// NewPersonaRoutes lets every user list personas while only admins can change them
+func NewPersonaRoutes(router *gin.RouterGroup, adminRouter *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, personaRepository domain.PersonaRepository) {
+	pc := &controller.PersonaController{
+		PersonaUsecase: usecases.NewPersonaUsecase(personaRepository, timeout),
+	}
+	router.GET("/personas", pc.GetPersonas)
+	router.GET("/personas/:key", pc.GetPersona)
+	adminRouter.POST("/personas", pc.CreatePersona)
+	adminRouter.PUT("/personas/:key", pc.UpdatePersona)
+	adminRouter.DELETE("/personas/:key", pc.DeletePersona)
+}
+
+func NewSkillRoutes(router *gin.RouterGroup, adminRouter *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, skillRepository domain.SkillRepository) {
+	sc := &controller.SkillController{
+		SkillUsecase: usecases.NewSkillUsecase(skillRepository, timeout),
+	}
+	router.GET("/skills", sc.GetSkills)
+	router.GET("/skills/:key", sc.GetSkill)
+	router.GET("/competencies", sc.GetCompetencyMap)
+	adminRouter.POST("/skills", sc.CreateSkill)
+	adminRouter.POST("/skills/retag", sc.RetagAll)
+	adminRouter.PUT("/skills/:key", sc.UpdateSkill)
+	adminRouter.DELETE("/skills/:key", sc.DeleteSkill)
+}
+
+func NewVoiceAnswerRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db mongo.Database, blobStore domain.BlobStore, speechToText domain.SpeechToText, roomRepository domain.RoomRepository) {
+	vr := repository.NewVoiceAnswerRepository(db, domain.CollectionVoiceAnswer, blobStore, speechToText, roomRepository)
+	vc := &controller.VoiceAnswerController{
+		VoiceAnswerUsecase: usecases.NewVoiceAnswerUsecase(vr, timeout),
+	}
+	router.POST("/rooms/:id/voice-answers", vc.SubmitVoiceAnswer)
+	router.GET("/voice-answers/:id", vc.GetVoiceAnswer)
+}
+
+func NewMessageAudioRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, messageAudioRepository domain.MessageAudioRepository) {
+	mc := &controller.MessageAudioController{
+		MessageAudioUsecase: usecases.NewMessageAudioUsecase(messageAudioRepository, timeout),
+	}
+	router.GET("/rooms/:id/messages/:message_id/audio", mc.GetMessageAudio)
+}
+
+func NewProgressRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db mongo.Database) {
+	pc := &controller.ProgressController{
+		ProgressUsecase: usecases.NewProgressUsecase(repository.NewProgressRepository(db, domain.CollectionRoom), timeout),
+	}
+	router.GET("/progress", pc.GetProgress)
+}
+
+func NewRecommendationRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db mongo.Database, roomRepository domain.RoomRepository) {
+	rr := repository.NewRecommendationRepository(db, domain.CollectionPracticePlan, roomRepository)
+	rc := &controller.RecommendationController{
+		RecommendationUsecase: usecases.NewRecommendationUsecase(rr, timeout),
+	}
+	router.GET("/recommendations", rc.GetRecommendations)
+	router.POST("/recommendations/start", rc.StartRecommendation)
+	router.POST("/practice-plan", rc.CreatePracticePlan)
+	router.GET("/practice-plan", rc.GetPracticePlan)
+}
+
+func NewReviewRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db mongo.Database, roomRepository domain.RoomRepository) {
+	rc := &controller.ReviewController{
+		ReviewUsecase: usecases.NewReviewUsecase(repository.NewReviewRepository(db, domain.CollectionReviewItem, roomRepository), timeout),
+	}
+	router.GET("/reviews", rc.GetReviewItems)
+	router.POST("/reviews/session", rc.CreateReviewSession)
+}
+
+func NewGoalRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, goalRepository domain.GoalRepository) {
+	gc := &controller.GoalController{
+		GoalUsecase: usecases.NewGoalUsecase(goalRepository, timeout),
+	}
+	router.POST("/goals", gc.CreateGoal)
+	router.GET("/goals", gc.GetGoals)
+	router.GET("/goals/progress", gc.GetGoalProgress)
+	router.GET("/goals/events", gc.GetGoalEvents)
+	router.DELETE("/goals/:id", gc.DeleteGoal)
+}
+
+func NewOrganizationRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, organizationRepository domain.OrganizationRepository) {
+	oc := &controller.OrganizationController{
+		OrganizationUsecase: usecases.NewOrganizationUsecase(organizationRepository, timeout),
+	}
+	router.POST("/organizations", oc.CreateOrganization)
+	router.GET("/organizations", oc.GetOrganizations)
+	router.GET("/organizations/:id/members", oc.GetMembers)
+	router.PUT("/organizations/:id/members/:user_id", oc.UpdateMemberRole)
+	router.DELETE("/organizations/:id/members/:user_id", oc.RemoveMember)
+	router.GET("/organizations/:id/members/:user_id/rooms", oc.GetMemberRooms)
+	router.GET("/organizations/:id/members/:user_id/rooms/:room_id", oc.GetMemberRoom)
+	router.POST("/organizations/:id/invitations", oc.CreateInvitation)
+	router.GET("/organizations/:id/invitations", oc.GetOrganizationInvitations)
+	router.DELETE("/organizations/:id/invitations/:invitation_id", oc.RevokeInvitation)
+	router.GET("/invitations", oc.GetMyInvitations)
+	router.POST("/invitations/:id/accept", oc.AcceptInvitation)
+}
+
+// NewCoachReviewRoutes serves annotations and score overrides to a room's candidate and coaches
+func NewCoachReviewRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db mongo.Database, organizationRepository domain.OrganizationRepository) {
+	cc := &controller.CoachReviewController{
+		CoachReviewUsecase: usecases.NewCoachReviewUsecase(repository.NewCoachReviewRepository(db, domain.CollectionAnnotation, organizationRepository), timeout),
+	}
+	router.GET("/rooms/:id/annotations", cc.GetAnnotations)
+	router.POST("/rooms/:id/annotations", cc.CreateAnnotation)
+	router.PUT("/rooms/:id/annotations/:annotation_id", cc.UpdateAnnotation)
+	router.DELETE("/rooms/:id/annotations/:annotation_id", cc.DeleteAnnotation)
+	router.PUT("/rooms/:id/feedback/:feedback_id/override", cc.OverrideScore)
+	router.DELETE("/rooms/:id/feedback/:feedback_id/override", cc.RemoveScoreOverride)
+	router.GET("/rooms/:id/score-overrides", cc.GetScoreOverrides)
+}
+
+// NewShareLinkRoutes lets candidates manage share links while anyone with a link reads its report
+func NewShareLinkRoutes(publicRouter *gin.RouterGroup, router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db mongo.Database, roomRepository domain.RoomRepository) {
+	sc := &controller.ShareLinkController{
+		ShareLinkUsecase: usecases.NewShareLinkUsecase(repository.NewShareLinkRepository(db, domain.CollectionShareLink, roomRepository), timeout),
+	}
+	router.POST("/rooms/:id/share-links", sc.CreateShareLink)
+	router.GET("/rooms/:id/share-links", sc.GetShareLinks)
+	router.DELETE("/rooms/:id/share-links/:link_id", sc.RevokeShareLink)
+	publicRouter.GET("/shared/:token", sc.GetSharedReport)
+}
+
+func NewExportRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db mongo.Database, roomRepository domain.RoomRepository) {
+	ec := &controller.ExportController{
+		ExportUsecase: usecases.NewExportUsecase(repository.NewExportRepository(db, roomRepository), timeout),
+	}
+	router.GET("/rooms/:id/export", ec.ExportRoom)
+	router.GET("/exports/rooms", ec.ExportRooms)
+	router.GET("/exports/schema", ec.GetExportSchema)
 }
 
 func NewOverallFeedbackRoutes(router *gin.RouterGroup, env *bootstrap.Env, timeout time.Duration, db *mongo.Database, geminiRepository domain.GeminiRepository, roomRepository domain.RoomRepository) {
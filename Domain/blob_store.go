@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStore stores uploaded and generated files such as resumes and audio
+type BlobStore interface {
+	// Key returns the canonical form of a key, rejecting keys that could point outside
+	// their owner's directory. Access checks must run on the canonical key.
+	Key(key string) (string, error)
+	// Put stores the content under key, replacing any existing blob, and returns its URL
+	Put(c context.Context, key string, contentType string, content io.Reader) (string, error)
+	// Get opens the blob stored under key together with its content type
+	Get(c context.Context, key string) (io.ReadCloser, string, error)
+	// Exists reports whether a blob is stored under key
+	Exists(c context.Context, key string) (bool, error)
+	Delete(c context.Context, key string) error
+	// URL returns the URL clients use to download the blob stored under key
+	URL(key string) string
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionAnnotation    = "annotations"
+	CollectionScoreOverride = "score_overrides"
+)
+
+// Score sources of a room's PerformancePercentage
+const (
+	ScoreSourceAI    = "ai"    // the AI grader's score
+	ScoreSourceCoach = "coach" // the AI score adjusted by coach overrides
+)
+
+// Annotation is a comment on a message of a room. Coaches start threads on a message;
+// coaches and the room's candidate reply to them.
+type Annotation struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	RoomID     primitive.ObjectID `bson:"room_id" json:"room_id"`
+	MessageID  primitive.ObjectID `bson:"message_id" json:"message_id"`
+	ParentID   primitive.ObjectID `bson:"parent_id,omitempty" json:"parent_id,omitempty"` // thread the annotation replies to
+	AuthorID   primitive.ObjectID `bson:"author_id" json:"author_id"`
+	AuthorName string             `bson:"author_name" json:"author_name"`
+	AuthorRole string             `bson:"author_role" json:"author_role"` // "coach" or "candidate"
+	Text       string             `bson:"text" json:"text"`
+	CreatedAt  int64              `bson:"created_at" json:"created_at"`
+	UpdatedAt  int64              `bson:"updated_at" json:"updated_at"`
+}
+
+// AnnotationThread is an annotation with its replies, oldest first
+type AnnotationThread struct {
+	Annotation
+	Replies []Annotation `json:"replies"`
+}
+
+// ScoreOverride is a coach's score for an answer that replaces the AI score in
+// aggregates. The AI score stays on the feedback next to it.
+type ScoreOverride struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	RoomID     primitive.ObjectID `bson:"room_id" json:"room_id"`
+	MessageID  primitive.ObjectID `bson:"message_id" json:"message_id"`   // first reply of the answer, stable across feedback runs
+	FeedbackID primitive.ObjectID `bson:"feedback_id" json:"feedback_id"` // feedback the coach overrode
+	CoachID    primitive.ObjectID `bson:"coach_id" json:"coach_id"`
+	CoachName  string             `bson:"coach_name" json:"coach_name"`
+	Score      int                `bson:"score" json:"score"`
+	AIScore    int                `bson:"ai_score" json:"ai_score"` // AI score when the override was made
+	Reason     string             `bson:"reason" json:"reason"`
+	CreatedAt  int64              `bson:"created_at" json:"created_at"`
+}
+
+type AnnotationRequest struct {
+	MessageID string `json:"message_id"`
+	ParentID  string `json:"parent_id"`
+	Text      string `json:"text" binding:"required"`
+}
+
+type AnnotationUpdateRequest struct {
+	Text string `json:"text" binding:"required"`
+}
+
+type ScoreOverrideRequest struct {
+	Score  *int   `json:"score" binding:"required"`
+	Reason string `json:"reason" binding:"required"`
+}
+
+type CoachReviewRepository interface {
+	GetAnnotations(c context.Context, userID primitive.ObjectID, roomID string) ([]AnnotationThread, error)
+	CreateAnnotation(c context.Context, userID primitive.ObjectID, roomID string, request AnnotationRequest) (Annotation, error)
+	UpdateAnnotation(c context.Context, userID primitive.ObjectID, roomID string, annotationID string, text string) (Annotation, error)
+	DeleteAnnotation(c context.Context, userID primitive.ObjectID, roomID string, annotationID string) error
+	OverrideScore(c context.Context, userID primitive.ObjectID, roomID string, feedbackID string, request ScoreOverrideRequest) (Room, error)
+	RemoveScoreOverride(c context.Context, userID primitive.ObjectID, roomID string, feedbackID string) (Room, error)
+	GetScoreOverrides(c context.Context, userID primitive.ObjectID, roomID string) ([]ScoreOverride, error)
+}
+
+type CoachReviewUsecase interface {
+	GetAnnotations(c context.Context, userID primitive.ObjectID, roomID string) ([]AnnotationThread, error)
+	CreateAnnotation(c context.Context, userID primitive.ObjectID, roomID string, request AnnotationRequest) (Annotation, error)
+	UpdateAnnotation(c context.Context, userID primitive.ObjectID, roomID string, annotationID string, text string) (Annotation, error)
+	DeleteAnnotation(c context.Context, userID primitive.ObjectID, roomID string, annotationID string) error
+	OverrideScore(c context.Context, userID primitive.ObjectID, roomID string, feedbackID string, request ScoreOverrideRequest) (Room, error)
+	RemoveScoreOverride(c context.Context, userID primitive.ObjectID, roomID string, feedbackID string) (Room, error)
+	GetScoreOverrides(c context.Context, userID primitive.ObjectID, roomID string) ([]ScoreOverride, error)
+}
@@ -0,0 +1,13 @@
+package domain
+
+// DeliveryMetrics describes how a spoken answer was delivered, computed from its transcript
+type DeliveryMetrics struct {
+	WordCount           int            `bson:"word_count" json:"word_count"`
+	DurationSeconds     float64        `bson:"duration_seconds" json:"duration_seconds"`
+	WordsPerMinute      float64        `bson:"words_per_minute" json:"words_per_minute"`
+	FillerWordCount     int            `bson:"filler_word_count" json:"filler_word_count"`
+	FillerWords         map[string]int `bson:"filler_words" json:"filler_words"`
+	LongPauseCount      int            `bson:"long_pause_count" json:"long_pause_count"`
+	LongestPauseSeconds float64        `bson:"longest_pause_seconds" json:"longest_pause_seconds"`
+	AnswerCount         int            `bson:"answer_count" json:"answer_count"` // number of recordings aggregated
+}
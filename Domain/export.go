@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Export formats
+const (
+	ExportFormatJSON     = "json"     // ExportDocument, described by the schema at GET /exports/schema
+	ExportFormatMarkdown = "markdown" // readable text for notes and wikis
+	ExportFormatHTML     = "html"     // self-contained page, prints to PDF from any browser
+	ExportFormatPDF      = "pdf"
+)
+
+// ExportSchemaVersion is bumped whenever a field of ExportDocument changes meaning or is removed.
+// Adding fields does not change the version.
+const ExportSchemaVersion = 1
+
+// ExportDocument is the JSON export of one or more rooms
+type ExportDocument struct {
+	SchemaVersion int            `json:"schema_version"`
+	ExportedAt    int64          `json:"exported_at"`
+	Rooms         []ExportedRoom `json:"rooms"`
+}
+
+// ExportedRoom is the report of a room with the room's ID
+type ExportedRoom struct {
+	RoomID string `json:"room_id"`
+	Report
+}
+
+// ExportFile is a rendered export ready to download
+type ExportFile struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+type ExportRepository interface {
+	ExportRoom(c context.Context, userID primitive.ObjectID, roomID string, format string) (ExportFile, error)
+	ExportRooms(c context.Context, userID primitive.ObjectID, format string) (ExportFile, error)
+}
+
+type ExportUsecase interface {
+	ExportRoom(c context.Context, userID primitive.ObjectID, roomID string, format string) (ExportFile, error)
+	ExportRooms(c context.Context, userID primitive.ObjectID, format string) (ExportFile, error)
+}
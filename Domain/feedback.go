@@ -12,17 +12,63 @@ const (
 
 type Feedback struct {
 	ID              primitive.ObjectID `json:"id"`
+	RunID           primitive.ObjectID `json:"run_id"` // feedback run that produced this feedback
 	UserID          primitive.ObjectID `json:"user_id"`
 	RoomID          primitive.ObjectID `json:"room_id"`
-	MessageID       primitive.ObjectID `json:"message_id"`
+	MessageID       primitive.ObjectID `json:"message_id"` // first reply of the candidate
+	AnswerMessageIDs []primitive.ObjectID `json:"answer_message_ids"` // every reply graded in this feedback
+	QuestionID      primitive.ObjectID `json:"question_id"` // bank question, zero for improvised questions
+	Persona         string             `json:"persona"`     // persona that asked the question in persona rooms
 	Question        string             `json:"question"`
+	Skills          []string           `json:"skills,omitempty"` // skill keys of the question
 	Answer          string             `json:"answer"`
 	Strength        []string           `json:"strength"`
 	ToImprove       []string           `json:"to_improve"`
-	ScorePercentage int                `json:"score_percentage"`
+	STAR            *STARAnalysis      `json:"star,omitempty"` // behavioral rooms only
+	ScorePercentage int                `json:"score_percentage"` // weighted from CriterionScores, after ScorePenalty
+	CriterionScores []CriterionScore   `json:"criterion_scores,omitempty"`
+	Details         map[string]interface{} `json:"details,omitempty"` // extra fields requested by the template's feedback schema
+	DeliveryMetrics *DeliveryMetrics       `json:"delivery_metrics,omitempty"` // spoken delivery of voice answers
+	AssistanceUsed  []string               `json:"assistance_used,omitempty"`  // hints and model answers shown before answering
+	ScorePenalty    int                    `json:"score_penalty,omitempty"`    // points deducted from the grader's score for AssistanceUsed
+	CoachOverride   *ScoreOverride         `json:"coach_override,omitempty"`   // coach score that is authoritative over ScorePercentage
 	CreatedAt       int64              `json:"created_at"`
 }
 
+// STAR method components
+const (
+	STARSituation = "situation"
+	STARTask      = "task"
+	STARAction    = "action"
+	STARResult    = "result"
+)
+
+// STARAnalysis breaks a behavioral answer down into Situation, Task, Action and Result
+type STARAnalysis struct {
+	Situation      STARComponent `json:"situation"`
+	Task           STARComponent `json:"task"`
+	Action         STARComponent `json:"action"`
+	Result         STARComponent `json:"result"`
+	Missing        []string      `json:"missing"`         // components the answer does not cover
+	ExemplarAnswer string        `json:"exemplar_answer"` // the answer rewritten to cover all four components
+}
+
+// STARComponent is how well an answer covers one STAR component
+type STARComponent struct {
+	Covered bool   `json:"covered"`
+	Quote   string `json:"quote"`   // part of the answer that covers the component
+	Comment string `json:"comment"` // what is missing or could be stronger
+}
+
+// CriterionScore is the grade of an answer on one rubric criterion
+type CriterionScore struct {
+	Criterion string   `json:"criterion"`
+	Score     int      `json:"score"`
+	Weight    float64  `json:"weight"`
+	Evidence  []string `json:"evidence"` // quotes from the answer that support the score
+	Comment   string   `json:"comment"`
+}
+
 type FeedbackRepository interface {
 	GetFeedback(c context.Context, roomID string) ([]Feedback, error)
 }
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionFeedbackRun = "feedback_runs"
+)
+
+// FeedbackRun is one grading of a completed room. Completing a room creates the
+// first run and every re-evaluation adds another; the room shows the feedback of
+// its canonical run.
+type FeedbackRun struct {
+	ID                    primitive.ObjectID `bson:"_id,omitempty"`
+	RoomID                primitive.ObjectID `bson:"room_id"`
+	UserID                primitive.ObjectID `bson:"user_id"`
+	Model                 string             `bson:"model"`
+	PromptVersion         int                `bson:"prompt_version"`
+	TemplateVersion       int                `bson:"template_version"`
+	PerformancePercentage int64              `bson:"performance_percentage"`
+	CriterionScores       map[string]int     `bson:"criterion_scores,omitempty"`
+	FeedbackCount         int                `bson:"feedback_count"`
+	Canonical             bool               `bson:"-"`
+	Feedback              []Feedback         `bson:"-"` // loaded when a single run is requested
+	CreatedAt             int64              `bson:"created_at"`
+}
+
+type ReevaluateRequest struct {
+	Model string `json:"model"` // Gemini model to grade with; the default model when empty
+}
@@ -1,6 +1,10 @@
 package domain
 
+// DefaultGeminiModel is used when a request does not name a model
+const DefaultGeminiModel = "gemini-2.0-flash"
+
 type GeminiRequest struct {
+	Model    string `json:"-"` // DefaultGeminiModel when empty
 	Contents []struct {
 		Parts []struct {
 			Text string `json:"text"`
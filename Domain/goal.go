@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionGoal      = "goals"
+	CollectionUserBadge = "user_badges"
+	CollectionGoalEvent = "goal_events"
+)
+
+// Goal types
+const (
+	GoalTypeRoomsPerWeek = "rooms_per_week" // complete Target rooms every week; recurring
+	GoalTypeReachScore   = "reach_score"    // average Target% over the latest matching rooms; met once
+	GoalTypeStreak       = "streak_days"    // practice Target days in a row; met once
+)
+
+// Goal statuses
+const (
+	GoalStatusActive   = "active"
+	GoalStatusAchieved = "achieved"
+)
+
+// Goal event types
+const (
+	GoalEventGoalMet     = "goal_met"
+	GoalEventBadgeEarned = "badge_earned"
+)
+
+// Goal is a practice target a user sets for themselves. InterviewType and Topic
+// optionally narrow down the rooms that count towards it.
+type Goal struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID        primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Type          string             `bson:"type" json:"type"`
+	Target        int                `bson:"target" json:"target"`
+	InterviewType string             `bson:"interview_type,omitempty" json:"interview_type,omitempty"`
+	Topic         string             `bson:"topic,omitempty" json:"topic,omitempty"`
+	Status        string             `bson:"status" json:"status"`
+	AchievedAt    int64              `bson:"achieved_at,omitempty" json:"achieved_at,omitempty"`
+	TimesMet      int                `bson:"times_met" json:"times_met"`
+	LastMetPeriod int64              `bson:"last_met_period,omitempty" json:"last_met_period,omitempty"` // week a recurring goal was last met
+	CreatedAt     int64              `bson:"created_at" json:"created_at"`
+}
+
+// GoalProgress is how far a user is towards a goal
+type GoalProgress struct {
+	Goal        Goal  `json:"goal"`
+	Current     int   `json:"current"`
+	Percent     int   `json:"percent"`
+	Met         bool  `json:"met"`
+	PeriodStart int64 `json:"period_start,omitempty"` // start of the week for recurring goals
+}
+
+// Streak counts consecutive days with at least one completed room
+type Streak struct {
+	Current         int   `json:"current"`
+	Longest         int   `json:"longest"`
+	LastPracticedAt int64 `json:"last_practiced_at"`
+}
+
+// Badge is an achievement awarded by a rule
+type Badge struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	EarnedAt    int64  `json:"earned_at,omitempty"`
+}
+
+// UserBadge records when a user earned a badge
+type UserBadge struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	UserID   primitive.ObjectID `bson:"user_id"`
+	Key      string             `bson:"key"`
+	EarnedAt int64              `bson:"earned_at"`
+}
+
+// GoalEvent is emitted when a goal is met or a badge is earned
+type GoalEvent struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID    primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Type      string             `bson:"type" json:"type"`
+	GoalID    primitive.ObjectID `bson:"goal_id,omitempty" json:"goal_id,omitempty"`
+	BadgeKey  string             `bson:"badge_key,omitempty" json:"badge_key,omitempty"`
+	Message   string             `bson:"message" json:"message"`
+	CreatedAt int64              `bson:"created_at" json:"created_at"`
+}
+
+// GoalSummary is a user's goal progress, streak and badges
+type GoalSummary struct {
+	Goals  []GoalProgress `json:"goals"`
+	Streak Streak         `json:"streak"`
+	Badges []Badge        `json:"badges"` // every badge; EarnedAt is zero for badges not earned yet
+	Events []GoalEvent    `json:"events"` // events emitted while computing this summary
+}
+
+type GoalRepository interface {
+	CreateGoal(c context.Context, goal Goal) (Goal, error)
+	GetGoals(c context.Context, userID primitive.ObjectID) ([]Goal, error)
+	DeleteGoal(c context.Context, userID primitive.ObjectID, goalID string) error
+	EvaluateGoals(c context.Context, userID primitive.ObjectID) (GoalSummary, error)
+	GetGoalEvents(c context.Context, userID primitive.ObjectID) ([]GoalEvent, error)
+}
+
+type GoalUsecase interface {
+	CreateGoal(c context.Context, goal Goal) (Goal, error)
+	GetGoals(c context.Context, userID primitive.ObjectID) ([]Goal, error)
+	DeleteGoal(c context.Context, userID primitive.ObjectID, goalID string) error
+	EvaluateGoals(c context.Context, userID primitive.ObjectID) (GoalSummary, error)
+	GetGoalEvents(c context.Context, userID primitive.ObjectID) ([]GoalEvent, error)
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionInterviewTemplate = "interview_templates"
+)
+
+// Interview types supported by the built-in templates
+const (
+	InterviewTypeGeneral      = "general"
+	InterviewTypeBehavioral   = "behavioral"
+	InterviewTypeSystemDesign = "system_design"
+	InterviewTypeCoding       = "coding"
+	InterviewTypeCase         = "case"
+)
+
+// InterviewTemplate describes how the interviewer behaves for one interview type.
+// Templates are versioned: saving a template for an existing type creates a new
+// version, and rooms keep the version they were created with.
+type InterviewTemplate struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Type           string             `bson:"type" json:"type"`
+	Version        int                `bson:"version" json:"version"`
+	Name           string             `bson:"name" json:"name"`
+	Persona        string             `bson:"persona" json:"persona"`
+	QuestionFlow   []string           `bson:"question_flow" json:"question_flow"`
+	Rubric         []string           `bson:"rubric" json:"rubric"`
+	Criteria       []RubricCriterion  `bson:"criteria" json:"criteria"` // weighted dimensions every answer is scored on
+	FeedbackSchema string             `bson:"feedback_schema" json:"feedback_schema"` // JSON example the grader must follow
+	CreatedAt      int64              `bson:"created_at" json:"created_at"`
+}
+
+// Rubric criteria used by the built-in templates
+const (
+	CriterionCorrectness   = "correctness"
+	CriterionDepth         = "depth"
+	CriterionCommunication = "communication"
+	CriterionStructure     = "structure"
+)
+
+// RubricCriterion is one scored dimension of an answer. Weights are relative to
+// the other criteria of the same template and do not need to add up to one.
+type RubricCriterion struct {
+	Key         string  `bson:"key" json:"key"`
+	Name        string  `bson:"name" json:"name"`
+	Description string  `bson:"description" json:"description"`
+	Weight      float64 `bson:"weight" json:"weight"`
+}
+
+type InterviewTemplateRepository interface {
+	CreateTemplate(c context.Context, template InterviewTemplate) (InterviewTemplate, error)
+	GetTemplates(c context.Context) ([]InterviewTemplate, error)
+	GetLatestTemplate(c context.Context, interviewType string) (InterviewTemplate, error)
+	GetTemplateVersion(c context.Context, interviewType string, version int) (InterviewTemplate, error)
+}
+
+type InterviewTemplateUsecase interface {
+	CreateTemplate(c context.Context, template InterviewTemplate) (InterviewTemplate, error)
+	GetTemplates(c context.Context) ([]InterviewTemplate, error)
+	GetLatestTemplate(c context.Context, interviewType string) (InterviewTemplate, error)
+	GetTemplateVersion(c context.Context, interviewType string, version int) (InterviewTemplate, error)
+}
@@ -0,0 +1,10 @@
+package domain
+
+// JobProfile is the structured summary of a job description a room is tailored to
+type JobProfile struct {
+	Title            string   `bson:"title" json:"title"`
+	Seniority        string   `bson:"seniority" json:"seniority"`
+	RequiredSkills   []string `bson:"required_skills" json:"required_skills"`
+	Responsibilities []string `bson:"responsibilities" json:"responsibilities"`
+	NiceToHave       []string `bson:"nice_to_have" json:"nice_to_have"`
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MessageAudioRepository produces spoken versions of interviewer messages.
+// Audio is cached in blob storage under the message ID, so each message is synthesized once.
+type MessageAudioRepository interface {
+	// SpeakMessage returns the audio URL for an interviewer message, synthesizing it if needed
+	SpeakMessage(c context.Context, userID primitive.ObjectID, message Message) (string, error)
+	// GetMessageAudio returns the audio URL for a message of one of the user's rooms and records it on the message
+	GetMessageAudio(c context.Context, userID primitive.ObjectID, roomID string, messageID string) (string, error)
+	// ForgetMessageAudio drops cached audio after a message's text changed
+	ForgetMessageAudio(c context.Context, userID primitive.ObjectID, messageID primitive.ObjectID) error
+}
+
+type MessageAudioUsecase interface {
+	GetMessageAudio(c context.Context, userID primitive.ObjectID, roomID string, messageID string) (string, error)
+}
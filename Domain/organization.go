@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionOrganization       = "organizations"
+	CollectionOrganizationMember = "organization_members"
+	CollectionInvitation         = "organization_invitations"
+)
+
+// Organization member roles
+const (
+	OrganizationRoleOwner     = "owner"     // manages members and invitations, reads candidates' rooms
+	OrganizationRoleCoach     = "coach"     // invites candidates, reads, annotates and rescores their rooms
+	OrganizationRoleCandidate = "candidate" // practices; their rooms are visible to the organization's coaches
+)
+
+// Invitation statuses
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusRevoked  = "revoked"
+)
+
+// Organization groups coaches and the candidates they review, such as a bootcamp cohort
+type Organization struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Name      string             `bson:"name" json:"name"`
+	OwnerID   primitive.ObjectID `bson:"owner_id" json:"owner_id"` // user who created the organization
+	CreatedAt int64              `bson:"created_at" json:"created_at"`
+}
+
+// OrganizationMember is a user's membership and role in an organization
+type OrganizationMember struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	OrganizationID primitive.ObjectID `bson:"organization_id" json:"organization_id"`
+	UserID         primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Username       string             `bson:"username" json:"username"`
+	Email          string             `bson:"email" json:"email"`
+	Role           string             `bson:"role" json:"role"`
+	JoinedAt       int64              `bson:"joined_at" json:"joined_at"`
+}
+
+// Invitation asks the user with Email to join an organization with Role
+type Invitation struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	OrganizationID   primitive.ObjectID `bson:"organization_id" json:"organization_id"`
+	OrganizationName string             `bson:"organization_name" json:"organization_name"`
+	Email            string             `bson:"email" json:"email"`
+	Role             string             `bson:"role" json:"role"`
+	InvitedBy        primitive.ObjectID `bson:"invited_by" json:"invited_by"`
+	Status           string             `bson:"status" json:"status"`
+	ExpiresAt        int64              `bson:"expires_at" json:"expires_at"`
+	CreatedAt        int64              `bson:"created_at" json:"created_at"`
+	AcceptedAt       int64              `bson:"accepted_at,omitempty" json:"accepted_at,omitempty"`
+}
+
+type OrganizationRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type InvitationRequest struct {
+	Email string `json:"email" binding:"required"`
+	Role  string `json:"role" binding:"required"`
+}
+
+type MemberRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// OrganizationRepository manages organizations. Every method takes the acting user and
+// checks their membership and role, so data never crosses organization boundaries.
+type OrganizationRepository interface {
+	CreateOrganization(c context.Context, userID primitive.ObjectID, name string) (Organization, error)
+	GetOrganizations(c context.Context, userID primitive.ObjectID) ([]Organization, error)
+	GetMembers(c context.Context, userID primitive.ObjectID, organizationID string) ([]OrganizationMember, error)
+	UpdateMemberRole(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string, role string) (OrganizationMember, error)
+	RemoveMember(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string) error
+	CreateInvitation(c context.Context, userID primitive.ObjectID, organizationID string, request InvitationRequest) (Invitation, error)
+	GetOrganizationInvitations(c context.Context, userID primitive.ObjectID, organizationID string) ([]Invitation, error)
+	RevokeInvitation(c context.Context, userID primitive.ObjectID, organizationID string, invitationID string) error
+	GetMyInvitations(c context.Context, userID primitive.ObjectID) ([]Invitation, error)
+	AcceptInvitation(c context.Context, userID primitive.ObjectID, invitationID string) (OrganizationMember, error)
+	GetMemberRooms(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string) ([]Room, error)
+	GetMemberRoom(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string, roomID string) (Room, error)
+	CoachMembership(c context.Context, coachID primitive.ObjectID, candidateID primitive.ObjectID) (OrganizationMember, error)
+}
+
+type OrganizationUsecase interface {
+	CreateOrganization(c context.Context, userID primitive.ObjectID, name string) (Organization, error)
+	GetOrganizations(c context.Context, userID primitive.ObjectID) ([]Organization, error)
+	GetMembers(c context.Context, userID primitive.ObjectID, organizationID string) ([]OrganizationMember, error)
+	UpdateMemberRole(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string, role string) (OrganizationMember, error)
+	RemoveMember(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string) error
+	CreateInvitation(c context.Context, userID primitive.ObjectID, organizationID string, request InvitationRequest) (Invitation, error)
+	GetOrganizationInvitations(c context.Context, userID primitive.ObjectID, organizationID string) ([]Invitation, error)
+	RevokeInvitation(c context.Context, userID primitive.ObjectID, organizationID string, invitationID string) error
+	GetMyInvitations(c context.Context, userID primitive.ObjectID) ([]Invitation, error)
+	AcceptInvitation(c context.Context, userID primitive.ObjectID, invitationID string) (OrganizationMember, error)
+	GetMemberRooms(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string) ([]Room, error)
+	GetMemberRoom(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string, roomID string) (Room, error)
+}
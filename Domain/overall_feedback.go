@@ -16,11 +16,28 @@ type OverallFeedback struct {
 	Strength        []string           `json:"strength"`
 	Improvement     []string           `json:"improvement"`
 	TopTopic        string             `json:"top_topic"`
-	TotalInterview  int64              `json:"total_interview"`
-	ScorePercentage int                `json:"score_percentage"`
+	WeakestTopic    string             `json:"weakest_topic,omitempty"`
+	TopicScores     []TopicScore       `json:"topic_scores"`
+	TotalInterview  int64              `json:"total_interview"` // completed interviews
+	StartedInterviews int64            `json:"started_interviews"`
+	CompletionRate  float64            `json:"completion_rate"` // percentage of started interviews that were completed
+	ScorePercentage int                `json:"score_percentage"` // AverageScore rounded
+	AverageScore    float64            `json:"average_score"`
+	CoachScoredRooms int64             `json:"coach_scored_rooms"` // completed interviews whose score a coach adjusted
+	DeliveryMetrics *DeliveryMetrics   `json:"delivery_metrics,omitempty"` // aggregated over all voice answers
+	RoomIDs         []primitive.ObjectID `json:"room_ids"`              // completed rooms this snapshot covers
+	RoomFingerprints map[string]string   `json:"room_fingerprints,omitempty"` // room ID → canonical run and score the snapshot saw
+	PreviousID      primitive.ObjectID   `json:"previous_id,omitempty"` // snapshot this one was updated from
 	CreatedAt       int64              `json:"created_at"`
 }
 
+// TopicScore is the average score of the completed interviews on one topic
+type TopicScore struct {
+	Topic        string  `json:"topic"`
+	AverageScore float64 `json:"average_score"`
+	Count        int     `json:"count"`
+}
+
 type OverallFeedbackRepository interface {
 	CreateOverallFeedback(c context.Context, overallFeedback OverallFeedback) error
 	GetOverallFeedback(c context.Context, userID primitive.ObjectID) ([]OverallFeedback, error)
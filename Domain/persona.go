@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionPersona = "personas"
+)
+
+// Built-in persona keys
+const (
+	PersonaHRScreener    = "hr_screener"
+	PersonaStaffEngineer = "staff_engineer"
+	PersonaHiringManager = "hiring_manager"
+)
+
+// Persona is an interviewer character. Rooms reference personas by Key;
+// a room with several personas runs as a panel where they take turns.
+type Persona struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	Key         string             `bson:"key"`
+	Name        string             `bson:"name"`
+	Title       string             `bson:"title"`
+	Description string             `bson:"description"` // personality and interviewing style
+	Focus       []string           `bson:"focus"`
+	CreatedAt   int64              `bson:"created_at"`
+	UpdatedAt   int64              `bson:"updated_at"`
+}
+
+type PersonaRepository interface {
+	CreatePersona(c context.Context, persona Persona) (Persona, error)
+	GetPersonas(c context.Context) ([]Persona, error)
+	GetPersona(c context.Context, key string) (Persona, error)
+	UpdatePersona(c context.Context, key string, persona Persona) (Persona, error)
+	DeletePersona(c context.Context, key string) error
+}
+
+type PersonaUsecase interface {
+	CreatePersona(c context.Context, persona Persona) (Persona, error)
+	GetPersonas(c context.Context) ([]Persona, error)
+	GetPersona(c context.Context, key string) (Persona, error)
+	UpdatePersona(c context.Context, key string, persona Persona) (Persona, error)
+	DeletePersona(c context.Context, key string) error
+}
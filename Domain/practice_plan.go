@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionPracticePlan = "practice_plans"
+)
+
+// Recommendation proposes the next interview room to practice with
+type Recommendation struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"` // set for recommendations of a practice plan
+	Role          string             `bson:"role" json:"role"`
+	Topic         string             `bson:"topic" json:"topic"`
+	Difficulty    string             `bson:"difficulty" json:"difficulty"`
+	InterviewType string             `bson:"interview_type" json:"interview_type"`
+	Focus         []string           `bson:"focus" json:"focus"` // rubric criteria the room should train
+	Reason        string             `bson:"reason" json:"reason"`
+	RoomID        primitive.ObjectID `bson:"room_id,omitempty" json:"room_id,omitempty"` // room created from the recommendation
+}
+
+// PracticeWeek is one week of a practice plan
+type PracticeWeek struct {
+	Week            int              `bson:"week" json:"week"`
+	Goal            string           `bson:"goal" json:"goal"`
+	Recommendations []Recommendation `bson:"recommendations" json:"recommendations"`
+}
+
+// PracticePlan is a user's multi-week practice plan. A user has one plan;
+// creating a new one replaces it.
+type PracticePlan struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID       primitive.ObjectID `bson:"user_id" json:"user_id"`
+	WeakTopics   []string           `bson:"weak_topics" json:"weak_topics"`
+	WeakCriteria []string           `bson:"weak_criteria" json:"weak_criteria"`
+	Weeks        []PracticeWeek     `bson:"weeks" json:"weeks"`
+	CreatedAt    int64              `bson:"created_at" json:"created_at"`
+}
+
+type PracticePlanRequest struct {
+	Weeks        int `json:"weeks"`
+	RoomsPerWeek int `json:"rooms_per_week"`
+}
+
+type RecommendationRepository interface {
+	GetRecommendations(c context.Context, userID primitive.ObjectID, limit int) ([]Recommendation, error)
+	CreatePracticePlan(c context.Context, userID primitive.ObjectID, request PracticePlanRequest) (PracticePlan, error)
+	GetPracticePlan(c context.Context, userID primitive.ObjectID) (PracticePlan, error)
+	StartRecommendation(c context.Context, userID primitive.ObjectID, recommendation Recommendation) (Room, error)
+}
+
+type RecommendationUsecase interface {
+	GetRecommendations(c context.Context, userID primitive.ObjectID, limit int) ([]Recommendation, error)
+	CreatePracticePlan(c context.Context, userID primitive.ObjectID, request PracticePlanRequest) (PracticePlan, error)
+	GetPracticePlan(c context.Context, userID primitive.ObjectID) (PracticePlan, error)
+	StartRecommendation(c context.Context, userID primitive.ObjectID, recommendation Recommendation) (Room, error)
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Progress dimensions
+const (
+	ProgressDimensionTopic     = "topic"
+	ProgressDimensionRole      = "role"
+	ProgressDimensionCriterion = "criterion"
+)
+
+// ProgressQuery selects the period and smoothing of a progress report
+type ProgressQuery struct {
+	Weeks  int // how many weeks back to include
+	Window int // number of weeks in each moving average
+}
+
+// ProgressPoint is the average score of the rooms completed in one week
+type ProgressPoint struct {
+	WeekStart     int64   `json:"week_start"` // unix time of the Monday the week starts on (UTC)
+	AverageScore  float64 `json:"average_score"`
+	MovingAverage float64 `json:"moving_average"` // over the buckets of this and the previous Window-1 weeks
+	Count         int     `json:"count"`
+}
+
+// ProgressSeries is the weekly score trend of one topic, role or rubric criterion
+type ProgressSeries struct {
+	Key          string          `json:"key"`
+	AverageScore float64         `json:"average_score"` // over the whole period
+	Count        int             `json:"count"`
+	Points       []ProgressPoint `json:"points"`
+}
+
+// ProgressArea is a topic or rubric criterion ranked among the user's best or worst
+type ProgressArea struct {
+	Dimension    string  `json:"dimension"`
+	Key          string  `json:"key"`
+	AverageScore float64 `json:"average_score"`
+	Count        int     `json:"count"`
+}
+
+// ProgressReport is a user's score trends across their completed rooms
+type ProgressReport struct {
+	UserID      primitive.ObjectID `json:"user_id"`
+	Weeks       int                `json:"weeks"`
+	Window      int                `json:"window"`
+	Overall     ProgressSeries     `json:"overall"`
+	Topics      []ProgressSeries   `json:"topics"`
+	Roles       []ProgressSeries   `json:"roles"`
+	Criteria    []ProgressSeries   `json:"criteria"`
+	BestAreas   []ProgressArea     `json:"best_areas"`
+	WorstAreas  []ProgressArea     `json:"worst_areas"`
+	GeneratedAt int64              `json:"generated_at"`
+}
+
+type ProgressRepository interface {
+	GetProgress(c context.Context, userID primitive.ObjectID, query ProgressQuery) (ProgressReport, error)
+}
+
+type ProgressUsecase interface {
+	GetProgress(c context.Context, userID primitive.ObjectID, query ProgressQuery) (ProgressReport, error)
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionQuestionBank = "question_bank"
+)
+
+const (
+	DifficultyEasy   = "easy"
+	DifficultyMedium = "medium"
+	DifficultyHard   = "hard"
+)
+
+// Question is a curated interview question maintained by admins
+type Question struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Text            string             `bson:"text" json:"text"`
+	Role            string             `bson:"role" json:"role"`
+	Topic           string             `bson:"topic" json:"topic"`
+	Difficulty      string             `bson:"difficulty" json:"difficulty"`
+	Tags            []string           `bson:"tags" json:"tags"`
+	Skills          []string           `bson:"skills" json:"skills"` // skill keys, tagged from the text when left empty
+	ReferenceAnswer string             `bson:"reference_answer" json:"reference_answer"`
+	Rubric          []string           `bson:"rubric" json:"rubric"`
+	CreatedAt       int64              `bson:"created_at" json:"created_at"`
+	UpdatedAt       int64              `bson:"updated_at" json:"updated_at"`
+}
+
+// QuestionFilter narrows down bank questions; empty fields match everything
+type QuestionFilter struct {
+	Role       string
+	Topic      string
+	Difficulty string
+	Tags       []string
+}
+
+type QuestionBankRepository interface {
+	CreateQuestion(c context.Context, question Question) (Question, error)
+	GetQuestion(c context.Context, questionID string) (Question, error)
+	GetQuestions(c context.Context, filter QuestionFilter) ([]Question, error)
+	UpdateQuestion(c context.Context, questionID string, question Question) (Question, error)
+	DeleteQuestion(c context.Context, questionID string) error
+	ImportQuestions(c context.Context, questions []Question) (int, error)
+	SampleQuestions(c context.Context, filter QuestionFilter, count int) ([]Question, error)
+}
+
+type QuestionBankUsecase interface {
+	CreateQuestion(c context.Context, question Question) (Question, error)
+	GetQuestion(c context.Context, questionID string) (Question, error)
+	GetQuestions(c context.Context, filter QuestionFilter) ([]Question, error)
+	UpdateQuestion(c context.Context, questionID string, question Question) (Question, error)
+	DeleteQuestion(c context.Context, questionID string) error
+	ImportQuestions(c context.Context, questions []Question) (int, error)
+}
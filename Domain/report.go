@@ -0,0 +1,43 @@
+package domain
+
+// Report is a read-only view of a room for people outside the app: the transcript,
+// the feedback of every answer and the scores. It carries no IDs.
+type Report struct {
+	Candidate       string          `json:"candidate,omitempty"` // empty when the name is redacted
+	Role            string          `json:"role"`
+	Topic           string          `json:"topic"`
+	InterviewType   string          `json:"interview_type"`
+	Mode            string          `json:"mode,omitempty"`
+	Difficulty      string          `json:"difficulty,omitempty"`
+	Status          string          `json:"status"`
+	CreatedAt       int64           `json:"created_at"`
+	CompletedAt     int64           `json:"completed_at,omitempty"`
+	Score           int64           `json:"score"`    // authoritative room score
+	AIScore         int64           `json:"ai_score"` // room score of the AI grader
+	ScoreSource     string          `json:"score_source"`
+	CriterionScores map[string]int  `json:"criterion_scores,omitempty"`
+	Transcript      []ReportMessage `json:"transcript"`
+	Answers         []ReportAnswer  `json:"answers"`
+}
+
+// ReportMessage is a message of the transcript
+type ReportMessage struct {
+	Speaker   string `json:"speaker"` // "Candidate", "Interviewer", "Interviewer (persona)", "Hint" or "Model answer"
+	Text      string `json:"text"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// ReportAnswer is the feedback of one answer
+type ReportAnswer struct {
+	Question        string           `json:"question"`
+	Answer          string           `json:"answer"`
+	Score           int              `json:"score"`    // authoritative answer score
+	AIScore         int              `json:"ai_score"` // score of the AI grader, after ScorePenalty
+	ScoreSource     string           `json:"score_source"`
+	CoachReason     string           `json:"coach_reason,omitempty"` // why the coach overrode the AI score
+	Strengths       []string         `json:"strengths"`
+	ToImprove       []string         `json:"to_improve"`
+	CriterionScores []CriterionScore `json:"criterion_scores,omitempty"`
+	AssistanceUsed  []string         `json:"assistance_used,omitempty"`
+	ScorePenalty    int              `json:"score_penalty,omitempty"`
+}
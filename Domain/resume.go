@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CandidateProfile is the structured summary of a user's uploaded CV
+type CandidateProfile struct {
+	Summary    string           `bson:"summary" json:"summary"`
+	Experience []WorkExperience `bson:"experience" json:"experience"`
+	Projects   []Project        `bson:"projects" json:"projects"`
+	Skills     []string         `bson:"skills" json:"skills"`
+	ResumeURL  string           `bson:"resume_url" json:"resume_url"`
+	UpdatedAt  int64            `bson:"updated_at" json:"updated_at"`
+}
+
+type WorkExperience struct {
+	Company    string   `bson:"company" json:"company"`
+	Title      string   `bson:"title" json:"title"`
+	Duration   string   `bson:"duration" json:"duration"`
+	Highlights []string `bson:"highlights" json:"highlights"`
+}
+
+type Project struct {
+	Name         string   `bson:"name" json:"name"`
+	Description  string   `bson:"description" json:"description"`
+	Technologies []string `bson:"technologies" json:"technologies"`
+}
+
+// ResumeUpload is an uploaded CV file
+type ResumeUpload struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+type ResumeRepository interface {
+	UploadResume(c context.Context, userID primitive.ObjectID, upload ResumeUpload) (CandidateProfile, error)
+	GetCandidateProfile(c context.Context, userID primitive.ObjectID) (CandidateProfile, error)
+}
+
+type ResumeUsecase interface {
+	UploadResume(c context.Context, userID primitive.ObjectID, upload ResumeUpload) (CandidateProfile, error)
+	GetCandidateProfile(c context.Context, userID primitive.ObjectID) (CandidateProfile, error)
+}
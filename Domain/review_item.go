@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionReviewItem = "review_items"
+)
+
+// ReviewItem schedules a poorly answered question for spaced repetition review (SM-2)
+type ReviewItem struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID         primitive.ObjectID `bson:"user_id" json:"user_id"`
+	RoomID         primitive.ObjectID `bson:"room_id" json:"room_id"`       // room the question was first asked in
+	MessageID      primitive.ObjectID `bson:"message_id" json:"message_id"` // first reply to the question, identifies it across feedback runs
+	Role           string             `bson:"role" json:"role"`
+	Topic          string             `bson:"topic" json:"topic"`
+	Question       string             `bson:"question" json:"question"`
+	ToImprove      []string           `bson:"to_improve" json:"to_improve"`
+	ExemplarAnswer string             `bson:"exemplar_answer,omitempty" json:"exemplar_answer,omitempty"`
+	EaseFactor     float64            `bson:"ease_factor" json:"ease_factor"`
+	Interval       int                `bson:"interval" json:"interval"`       // days until the next review
+	Repetitions    int                `bson:"repetitions" json:"repetitions"` // successful reviews in a row
+	LastScore      int                `bson:"last_score" json:"last_score"`
+	DueAt          int64              `bson:"due_at" json:"due_at"`
+	ReviewedAt     int64              `bson:"reviewed_at,omitempty" json:"reviewed_at,omitempty"`
+	SessionRoomID  primitive.ObjectID `bson:"session_room_id,omitempty" json:"session_room_id,omitempty"` // review room the item is being asked in
+	CreatedAt      int64              `bson:"created_at" json:"created_at"`
+}
+
+type ReviewSessionRequest struct {
+	Count int `json:"count"`
+}
+
+type ReviewRepository interface {
+	GetReviewItems(c context.Context, userID primitive.ObjectID, dueOnly bool) ([]ReviewItem, error)
+	CreateReviewSession(c context.Context, userID primitive.ObjectID, count int) (Room, error)
+}
+
+type ReviewUsecase interface {
+	GetReviewItems(c context.Context, userID primitive.ObjectID, dueOnly bool) ([]ReviewItem, error)
+	CreateReviewSession(c context.Context, userID primitive.ObjectID, count int) (Room, error)
+}
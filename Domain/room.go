@@ -10,48 +10,157 @@ const (
 	CollectionRoom = "rooms"
 )
 
+// Room modes
+const (
+	RoomModeImprovised   = ""              // the interviewer invents its own questions
+	RoomModeQuestionBank = "question_bank" // the interviewer draws from the curated question bank
+	RoomModeReview       = "review"        // the interviewer re-asks questions due for spaced repetition review
+)
+
+// Message types
+const (
+	MessageTypeQuestion = "question"
+	MessageTypeFollowUp = "follow_up"
+	MessageTypeClosing  = "closing" // the interviewer's closing remarks
+	MessageTypeHint        = "hint"         // hint on the current question, asked for by the candidate
+	MessageTypeModelAnswer = "model_answer" // strong answer to the current question, asked for by the candidate
+)
+
 type Room struct {
 	ID        primitive.ObjectID `bson:"_id,omitempty"`
 	UserID    primitive.ObjectID `bson:"user_id"`
 	Role      string             `bson:"role"`
 	Topic     string             `bson:"topic"`
+	InterviewType   string       `bson:"interview_type"`
+	TemplateVersion int          `bson:"template_version"`
+	Mode            string       `bson:"mode"`
+	Difficulty      string       `bson:"difficulty"`
+	PlannedQuestions []PlannedQuestion `bson:"planned_questions,omitempty"`
+	CurrentQuestion  int               `bson:"current_question"`
+	JobDescription   string            `bson:"job_description,omitempty"`
+	JobProfile       *JobProfile       `bson:"job_profile,omitempty"`
+	CandidateProfile *CandidateProfile `bson:"candidate_profile,omitempty"` // snapshot of the user's CV at creation
+	Personas         []string          `bson:"personas,omitempty"`          // persona keys; more than one runs a panel
+	AutoSpeech       bool              `bson:"auto_speech"`                 // synthesize audio for every interviewer message
+	Focus            []string          `bson:"focus,omitempty"`             // rubric criteria the candidate wants to practice
+	Skills           []string          `bson:"skills,omitempty"`            // skill keys of the role and topic
 	Messages  []Message          `bson:"messages"`
-	PerformancePercentage int64 `bson:"performance_percentage"`
+	PerformancePercentage int64 `bson:"performance_percentage"` // authoritative score, see ScoreSource
+	AIPerformancePercentage int64 `bson:"ai_performance_percentage,omitempty"` // score of the canonical feedback run
+	ScoreSource           string `bson:"score_source,omitempty"` // "ai", or "coach" when coach overrides adjust the score
+	CriterionScores       map[string]int `bson:"criterion_scores,omitempty"` // average score per rubric criterion
+	CanonicalRunID        primitive.ObjectID `bson:"canonical_run_id,omitempty"` // feedback run shown in Feedback
 	Status    string             `bson:"status"`
 	Feedback  []Feedback         `bson:"feedback"`
 	CreatedAt int64              `bson:"created_at"`
+	CompletedAt int64            `bson:"completed_at,omitempty"`
+}
+
+// CreateRoomRequest is the body of POST /rooms. Only these fields are copied into
+// the new room; everything else is set by the server.
+type CreateRoomRequest struct {
+	Role           string   `json:"role"`
+	Topic          string   `json:"topic"`
+	InterviewType  string   `json:"interview_type"`
+	Mode           string   `json:"mode"`
+	Difficulty     string   `json:"difficulty"`
+	Personas       []string `json:"personas"`
+	Focus          []string `json:"focus"`
+	JobDescription string   `json:"job_description"`
+	AutoSpeech     bool     `json:"auto_speech"`
 }
 
 type RoomRequest struct {
-	UserID primitive.ObjectID `bson:"user_id"`
-	Role   string             `bson:"role"`
-	Topic  string             `bson:"topic"`
+	UserID        primitive.ObjectID `bson:"user_id"`
+	Role          string             `bson:"role"`
+	Topic         string             `bson:"topic"`
+	InterviewType string             `bson:"interview_type"`
+}
+
+// PlannedQuestion is a bank question the interviewer must ask in a room
+type PlannedQuestion struct {
+	QuestionID      primitive.ObjectID `bson:"question_id"` // review item ID in review rooms
+	Text            string             `bson:"text"`
+	ReferenceAnswer string             `bson:"reference_answer"`
+	Rubric          []string           `bson:"rubric"`
+	Skills          []string           `bson:"skills,omitempty"`
 }
 
 type Message struct {
 	ID      primitive.ObjectID `bson:"_id,omitempty"`
-	Sender    string `bson:"sender"` // "user" or "ai"
+	Sender    string `bson:"sender"` // "user" or "ai"; Persona identifies which interviewer sent an "ai" message
+	Persona   string `bson:"persona,omitempty"`
 	Text      string `bson:"text"`
 	VoiceURL  string `bson:"voice_url,omitempty"`
+	DeliveryMetrics *DeliveryMetrics `bson:"delivery_metrics,omitempty"` // set for voice answers
+	AudioURL  string `bson:"audio_url,omitempty"` // spoken version of an interviewer message
+	Versions  []MessageVersion `bson:"versions,omitempty"`  // earlier texts, oldest first, kept when the message is regenerated or edited
+	Retracted bool             `bson:"retracted,omitempty"` // retracted messages are ignored by the interviewer and feedback
+	Type       string             `bson:"type,omitempty"`        // "question", "follow_up" or "closing" for interviewer turns, "hint" or "model_answer" for help
+	QuestionID primitive.ObjectID `bson:"question_id,omitempty"` // planned question this message belongs to in question bank rooms
 	Timestamp int64  `bson:"timestamp"`
 }
 
+// MessageVersion is an earlier text of a message that was regenerated or edited
+type MessageVersion struct {
+	Text       string `bson:"text"`
+	VoiceURL   string `bson:"voice_url,omitempty"`
+	Timestamp  int64  `bson:"timestamp"`
+	ReplacedAt int64  `bson:"replaced_at"`
+}
+
+// UpdateRoomRequest is the body of PUT /rooms/:id. Only the fields that are sent are
+// changed; scores, feedback, messages and status are never written from a request.
+type UpdateRoomRequest struct {
+	Role       *string  `json:"role"`
+	Topic      *string  `json:"topic"`
+	Difficulty *string  `json:"difficulty"`
+	Focus      []string `json:"focus"`
+	AutoSpeech *bool    `json:"auto_speech"`
+}
+
+// MessageRequest is the body of POST /rooms/:id/messages; the server fills in everything else
+type MessageRequest struct {
+	Text string `json:"text" binding:"required"`
+}
+
+type EditAnswerRequest struct {
+	Text string `json:"text" binding:"required"`
+}
+
 type RoomRepository interface {
-	CreateRoom(c context.Context, room Room) (string, error)
+	CreateRoom(c context.Context, room Room) (Room, error)
 	GetRoom(c context.Context, roomID string) (Room, error)
+	GetUserRoom(c context.Context, userID primitive.ObjectID, roomID string) (Room, error)
 	GetRoomsWithUserID(c context.Context, userID primitive.ObjectID) ([]Room, error)
-	UpdateRoom(c context.Context, roomID string, room Room) (Room, error)
-	DeleteRoom(c context.Context, roomID string) error
-	AddMessageToRoom(c context.Context, roomID string, message Message) (Room, error)
+	UpdateRoom(c context.Context, userID primitive.ObjectID, roomID string, request UpdateRoomRequest) (Room, error)
+	DeleteRoom(c context.Context, userID primitive.ObjectID, roomID string) error
+	AddMessageToRoom(c context.Context, userID primitive.ObjectID, roomID string, message Message) (Room, error)
 	CompletedRoom(c context.Context, userID primitive.ObjectID, roomID string) (Room, error)
+	RegenerateLastMessage(c context.Context, userID primitive.ObjectID, roomID string) (Room, error)
+	EditLastAnswer(c context.Context, userID primitive.ObjectID, roomID string, text string) (Room, error)
+	RetractLastAnswer(c context.Context, userID primitive.ObjectID, roomID string) (Room, error)
+	RequestAssistance(c context.Context, userID primitive.ObjectID, roomID string, assistanceType string) (Room, error)
+	ReevaluateRoom(c context.Context, userID primitive.ObjectID, roomID string, model string) (FeedbackRun, error)
+	GetFeedbackRuns(c context.Context, userID primitive.ObjectID, roomID string) ([]FeedbackRun, error)
+	GetFeedbackRun(c context.Context, userID primitive.ObjectID, roomID string, runID string) (FeedbackRun, error)
+	SetCanonicalRun(c context.Context, userID primitive.ObjectID, roomID string, runID string) (Room, error)
 }
 
 type RoomUsecase interface {
-	CreateRoom(c context.Context, room Room) (string, error)
-	GetRoom(c context.Context, roomID string) (Room, error)
+	CreateRoom(c context.Context, room Room) (Room, error)
+	GetUserRoom(c context.Context, userID primitive.ObjectID, roomID string) (Room, error)
 	GetRoomsWithUserID(c context.Context, userID primitive.ObjectID) ([]Room, error)
-	UpdateRoom(c context.Context, roomID string, room Room) (Room, error)
-	DeleteRoom(c context.Context, roomID string) error
-	AddMessageToRoom(c context.Context, roomID string, message Message) (Room, error)
+	UpdateRoom(c context.Context, userID primitive.ObjectID, roomID string, request UpdateRoomRequest) (Room, error)
+	DeleteRoom(c context.Context, userID primitive.ObjectID, roomID string) error
+	AddMessageToRoom(c context.Context, userID primitive.ObjectID, roomID string, message Message) (Room, error)
 	CompletedRoom(c context.Context, userID primitive.ObjectID, roomID string) (Room, error)
+	RegenerateLastMessage(c context.Context, userID primitive.ObjectID, roomID string) (Room, error)
+	EditLastAnswer(c context.Context, userID primitive.ObjectID, roomID string, text string) (Room, error)
+	RetractLastAnswer(c context.Context, userID primitive.ObjectID, roomID string) (Room, error)
+	RequestAssistance(c context.Context, userID primitive.ObjectID, roomID string, assistanceType string) (Room, error)
+	ReevaluateRoom(c context.Context, userID primitive.ObjectID, roomID string, model string) (FeedbackRun, error)
+	GetFeedbackRuns(c context.Context, userID primitive.ObjectID, roomID string) ([]FeedbackRun, error)
+	GetFeedbackRun(c context.Context, userID primitive.ObjectID, roomID string, runID string) (FeedbackRun, error)
+	SetCanonicalRun(c context.Context, userID primitive.ObjectID, roomID string, runID string) (Room, error)
 }
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionShareLink = "share_links"
+)
+
+// ShareLink lets anyone with its token read the report of a completed room until it
+// expires or is revoked
+type ShareLink struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	RoomID         primitive.ObjectID `bson:"room_id" json:"room_id"`
+	UserID         primitive.ObjectID `bson:"user_id" json:"user_id"`
+	Token          string             `bson:"token" json:"token"`
+	RedactName     bool               `bson:"redact_name" json:"redact_name"` // hide the candidate's name from the report
+	AccessCount    int64              `bson:"access_count" json:"access_count"`
+	LastAccessedAt int64              `bson:"last_accessed_at,omitempty" json:"last_accessed_at,omitempty"`
+	ExpiresAt      int64              `bson:"expires_at" json:"expires_at"`
+	RevokedAt      int64              `bson:"revoked_at,omitempty" json:"revoked_at,omitempty"`
+	CreatedAt      int64              `bson:"created_at" json:"created_at"`
+}
+
+type ShareLinkRequest struct {
+	ExpiresInHours int  `json:"expires_in_hours"` // defaults to a week
+	RedactName     bool `json:"redact_name"`
+}
+
+type ShareLinkRepository interface {
+	CreateShareLink(c context.Context, userID primitive.ObjectID, roomID string, request ShareLinkRequest) (ShareLink, error)
+	GetShareLinks(c context.Context, userID primitive.ObjectID, roomID string) ([]ShareLink, error)
+	RevokeShareLink(c context.Context, userID primitive.ObjectID, roomID string, linkID string) error
+	GetSharedReport(c context.Context, token string) (Report, error)
+}
+
+type ShareLinkUsecase interface {
+	CreateShareLink(c context.Context, userID primitive.ObjectID, roomID string, request ShareLinkRequest) (ShareLink, error)
+	GetShareLinks(c context.Context, userID primitive.ObjectID, roomID string) ([]ShareLink, error)
+	RevokeShareLink(c context.Context, userID primitive.ObjectID, roomID string, linkID string) error
+	GetSharedReport(c context.Context, token string) (Report, error)
+}
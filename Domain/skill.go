@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionSkill = "skills"
+)
+
+// Skill is a node of the skill taxonomy. Rooms, questions and feedback are tagged
+// with skill keys so that "Go", "golang" and "Golang concurrency" add up to the same skills.
+type Skill struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	Key       string             `bson:"key" json:"key"`
+	Name      string             `bson:"name" json:"name"`
+	ParentKey string             `bson:"parent_key,omitempty" json:"parent_key,omitempty"` // empty for top level skills
+	Aliases   []string           `bson:"aliases" json:"aliases"`                           // other names and keywords that identify the skill
+	CreatedAt int64              `bson:"created_at" json:"created_at,omitempty"`
+	UpdatedAt int64              `bson:"updated_at" json:"updated_at,omitempty"`
+}
+
+// SkillCompetency is a user's average score on a skill, including its sub-skills
+type SkillCompetency struct {
+	Key             string  `json:"key"`
+	Name            string  `json:"name"`
+	ParentKey       string  `json:"parent_key,omitempty"`
+	Level           int     `json:"level"` // depth in the taxonomy, 0 for top level skills
+	AverageScore    float64 `json:"average_score"`
+	Answers         int     `json:"answers"`
+	Rooms           int     `json:"rooms"`
+	LastPracticedAt int64   `json:"last_practiced_at"`
+}
+
+// SkillRetagResult counts what a retagging run updated
+type SkillRetagResult struct {
+	Questions int `json:"questions"`
+	Rooms     int `json:"rooms"`
+}
+
+type SkillRepository interface {
+	CreateSkill(c context.Context, skill Skill) (Skill, error)
+	GetSkills(c context.Context) ([]Skill, error)
+	GetSkill(c context.Context, key string) (Skill, error)
+	UpdateSkill(c context.Context, key string, skill Skill) (Skill, error)
+	DeleteSkill(c context.Context, key string) error
+	TagText(c context.Context, text string, useLLM bool) ([]string, error)
+	RetagAll(c context.Context) (SkillRetagResult, error)
+	GetCompetencyMap(c context.Context, userID primitive.ObjectID) ([]SkillCompetency, error)
+}
+
+type SkillUsecase interface {
+	CreateSkill(c context.Context, skill Skill) (Skill, error)
+	GetSkills(c context.Context) ([]Skill, error)
+	GetSkill(c context.Context, key string) (Skill, error)
+	UpdateSkill(c context.Context, key string, skill Skill) (Skill, error)
+	DeleteSkill(c context.Context, key string) error
+	RetagAll(c context.Context) (SkillRetagResult, error)
+	GetCompetencyMap(c context.Context, userID primitive.ObjectID) ([]SkillCompetency, error)
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+	"io"
+)
+
+// TranscriptWord is a recognised word with its position in the audio, in seconds
+type TranscriptWord struct {
+	Word  string  `bson:"word" json:"word"`
+	Start float64 `bson:"start" json:"start"`
+	End   float64 `bson:"end" json:"end"`
+}
+
+type Transcript struct {
+	Text     string           `bson:"text" json:"text"`
+	Words    []TranscriptWord `bson:"words" json:"words"`
+	Duration float64          `bson:"duration" json:"duration"` // seconds of audio
+}
+
+// SpeechToText turns recorded audio into a transcript with word timestamps
+type SpeechToText interface {
+	Transcribe(c context.Context, audio io.Reader, contentType string) (Transcript, error)
+}
+
+// TextToSpeech renders interviewer messages as audio
+type TextToSpeech interface {
+	// Synthesize returns the encoded audio and its content type; voice selects the speaker, "" for the default
+	Synthesize(c context.Context, text string, voice string) ([]byte, string, error)
+}
@@ -9,5 +9,6 @@ type User struct {
 	Rooms    []string           `bson:"rooms"`
 	Password string             `bson:"password"`
 	OverallFeedback OverallFeedback `bson:"overall_feedback"`
+	CandidateProfile *CandidateProfile `bson:"candidate_profile,omitempty"`
 
 }
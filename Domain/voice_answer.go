@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CollectionVoiceAnswer = "voice_answers"
+)
+
+// Voice answer processing statuses
+const (
+	VoiceAnswerStatusPending    = "pending"
+	VoiceAnswerStatusProcessing = "processing"
+	VoiceAnswerStatusCompleted  = "completed"
+	VoiceAnswerStatusFailed     = "failed"
+)
+
+// VoiceAnswer tracks an uploaded audio answer while it is transcribed and added to its room
+type VoiceAnswer struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	RoomID      primitive.ObjectID `bson:"room_id"`
+	UserID      primitive.ObjectID `bson:"user_id"`
+	AudioKey    string             `bson:"audio_key"`
+	AudioURL    string             `bson:"audio_url"`
+	ContentType string             `bson:"content_type"`
+	Status      string             `bson:"status"`
+	Error       string             `bson:"error,omitempty"`
+	Transcript  string             `bson:"transcript,omitempty"`
+	MessageID   primitive.ObjectID `bson:"message_id,omitempty"` // user message created from the transcript
+	CreatedAt   int64              `bson:"created_at"`
+	UpdatedAt   int64              `bson:"updated_at"`
+}
+
+// AudioUpload is an uploaded audio recording
+type AudioUpload struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+type VoiceAnswerRepository interface {
+	// SubmitVoiceAnswer stores the audio and starts processing it in the background
+	SubmitVoiceAnswer(c context.Context, userID primitive.ObjectID, roomID string, upload AudioUpload) (VoiceAnswer, error)
+	GetVoiceAnswer(c context.Context, userID primitive.ObjectID, voiceAnswerID string) (VoiceAnswer, error)
+}
+
+type VoiceAnswerUsecase interface {
+	SubmitVoiceAnswer(c context.Context, userID primitive.ObjectID, roomID string, upload AudioUpload) (VoiceAnswer, error)
+	GetVoiceAnswer(c context.Context, userID primitive.ObjectID, voiceAnswerID string) (VoiceAnswer, error)
+}
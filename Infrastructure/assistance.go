@@ -0,0 +1,67 @@
+package infrastructure
+
+import (
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Score deductions for an answer given after the candidate asked for help
+const (
+	HintPenalty        = 10 // per hint
+	ModelAnswerPenalty = 50
+)
+
+// IsAssistance reports whether a message is a hint or model answer rather than part of the interview
+func IsAssistance(msg domain.Message) bool {
+	return msg.Type == domain.MessageTypeHint || msg.Type == domain.MessageTypeModelAnswer
+}
+
+// InterviewMessages returns the active questions and answers of a room, leaving out
+// retracted messages, hints and model answers
+func InterviewMessages(messages []domain.Message) []domain.Message {
+	interview := make([]domain.Message, 0, len(messages))
+	for _, msg := range ActiveMessages(messages) {
+		if !IsAssistance(msg) {
+			interview = append(interview, msg)
+		}
+	}
+	return interview
+}
+
+// AssistanceByAnswer maps each user answer to the hints and model answers shown
+// between the interviewer's question and the answer
+func AssistanceByAnswer(messages []domain.Message) map[primitive.ObjectID][]string {
+	assistance := make(map[primitive.ObjectID][]string)
+	var pending []string
+	for _, msg := range ActiveMessages(messages) {
+		switch {
+		case IsAssistance(msg):
+			pending = append(pending, msg.Type)
+		case msg.Sender == "user":
+			if len(pending) > 0 {
+				assistance[msg.ID] = pending
+			}
+			pending = nil
+		default:
+			pending = nil
+		}
+	}
+	return assistance
+}
+
+// AssistancePenalty is the score deduction for an answer that used the given help
+func AssistancePenalty(assistance []string) int {
+	penalty := 0
+	for _, kind := range assistance {
+		switch kind {
+		case domain.MessageTypeHint:
+			penalty += HintPenalty
+		case domain.MessageTypeModelAnswer:
+			penalty += ModelAnswerPenalty
+		}
+	}
+	if penalty > 100 {
+		penalty = 100
+	}
+	return penalty
+}
@@ -0,0 +1,102 @@
+package infrastructure
+
+import (
+	"math"
+	"sort"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// AuthoritativeScore returns the score of an answer that aggregates use: the coach's
+// override when there is one, otherwise the AI score
+func AuthoritativeScore(feedback domain.Feedback) (int, string) {
+	if feedback.CoachOverride != nil {
+		return feedback.CoachOverride.Score, domain.ScoreSourceCoach
+	}
+	return feedback.ScorePercentage, domain.ScoreSourceAI
+}
+
+// AIRoomScore returns the room score of the canonical feedback run. Rooms graded before
+// score sources existed only have PerformancePercentage, which is the AI score.
+func AIRoomScore(room domain.Room) int64 {
+	if room.ScoreSource == "" {
+		return room.PerformancePercentage
+	}
+	return room.AIPerformancePercentage
+}
+
+// overrideMatches reports whether an override was made on the answer of a feedback.
+// Overrides follow the answer's first reply across feedback runs; feedback stored
+// before replies were tracked is matched by its ID.
+func overrideMatches(override domain.ScoreOverride, feedback domain.Feedback) bool {
+	if !feedback.MessageID.IsZero() {
+		return override.MessageID == feedback.MessageID
+	}
+	return override.FeedbackID == feedback.ID
+}
+
+// ApplyScoreOverrides attaches the latest override of each answer to the room's feedback
+// and makes the room score coach-adjusted when any answer was overridden:
+//
+//	room score = AI room score + Σ (override(a) − AI score(a)) / answers
+//
+// clamped to 0-100. The AI scores of the feedback and the room are kept unchanged.
+func ApplyScoreOverrides(room *domain.Room, overrides []domain.ScoreOverride) {
+	aiScore := AIRoomScore(*room)
+	room.AIPerformancePercentage = aiScore
+	room.PerformancePercentage = aiScore
+	room.ScoreSource = domain.ScoreSourceAI
+
+	latest := append([]domain.ScoreOverride(nil), overrides...)
+	sort.SliceStable(latest, func(i, j int) bool { return latest[i].CreatedAt > latest[j].CreatedAt })
+
+	delta := 0
+	overridden := false
+	for i := range room.Feedback {
+		feedback := &room.Feedback[i]
+		feedback.CoachOverride = nil
+		for _, override := range latest {
+			if overrideMatches(override, *feedback) {
+				override := override
+				feedback.CoachOverride = &override
+				break
+			}
+		}
+		if feedback.CoachOverride != nil {
+			delta += feedback.CoachOverride.Score - feedback.ScorePercentage
+			overridden = true
+		}
+	}
+	if !overridden {
+		return
+	}
+
+	adjusted := math.Round(float64(aiScore) + float64(delta)/float64(len(room.Feedback)))
+	room.PerformancePercentage = int64(clampScore(int(adjusted)))
+	room.ScoreSource = domain.ScoreSourceCoach
+}
+
+// ThreadAnnotations groups annotations into threads: root annotations oldest first,
+// each with its replies oldest first. Replies whose thread is gone are dropped.
+func ThreadAnnotations(annotations []domain.Annotation) []domain.AnnotationThread {
+	sorted := append([]domain.Annotation(nil), annotations...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedAt < sorted[j].CreatedAt })
+
+	threads := []domain.AnnotationThread{}
+	index := make(map[string]int)
+	for _, annotation := range sorted {
+		if annotation.ParentID.IsZero() {
+			index[annotation.ID.Hex()] = len(threads)
+			threads = append(threads, domain.AnnotationThread{Annotation: annotation, Replies: []domain.Annotation{}})
+		}
+	}
+	for _, annotation := range sorted {
+		if annotation.ParentID.IsZero() {
+			continue
+		}
+		if i, ok := index[annotation.ParentID.Hex()]; ok {
+			threads[i].Replies = append(threads[i].Replies, annotation)
+		}
+	}
+	return threads
+}
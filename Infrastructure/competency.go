@@ -0,0 +1,104 @@
+package infrastructure
+
+import (
+	"sort"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// AnswerSkills returns the skills an answer counts towards: the skills of its question,
+// or those of its room when the question was not tagged. Rooms graded before skills
+// existed are tagged from their text with the keyword rules.
+func AnswerSkills(skills []domain.Skill, room domain.Room, feedback domain.Feedback) []string {
+	if len(feedback.Skills) > 0 {
+		return feedback.Skills
+	}
+	if tags := MatchSkills(skills, feedback.Question); len(tags) > 0 {
+		return tags
+	}
+	if len(room.Skills) > 0 {
+		return room.Skills
+	}
+	return MatchSkills(skills, room.Role+" "+room.Topic)
+}
+
+// BuildCompetencyMap averages the scores of every answer in the completed rooms per
+// skill. An answer also counts towards every ancestor of its skills, once each. Skills
+// that were never practiced are left out; the rest are listed depth first.
+func BuildCompetencyMap(skills []domain.Skill, rooms []domain.Room) []domain.SkillCompetency {
+	parents := SkillParents(skills)
+	totals := make(map[string]*domain.SkillCompetency)
+	roomsBySkill := make(map[string]map[string]bool)
+
+	for _, room := range rooms {
+		if room.Status != "completed" {
+			continue
+		}
+		practicedAt := room.CompletedAt
+		if practicedAt == 0 {
+			practicedAt = room.CreatedAt
+		}
+
+		for _, feedback := range room.Feedback {
+			counted := make(map[string]bool)
+			for _, key := range AnswerSkills(skills, room, feedback) {
+				if _, ok := parents[key]; !ok {
+					continue
+				}
+				for _, skill := range SkillAncestors(parents, key) {
+					if counted[skill] {
+						continue
+					}
+					counted[skill] = true
+
+					total, ok := totals[skill]
+					if !ok {
+						total = &domain.SkillCompetency{Key: skill}
+						totals[skill] = total
+						roomsBySkill[skill] = make(map[string]bool)
+					}
+					score, _ := AuthoritativeScore(feedback)
+					total.AverageScore += float64(score)
+					total.Answers++
+					roomsBySkill[skill][room.ID.Hex()] = true
+					if practicedAt > total.LastPracticedAt {
+						total.LastPracticedAt = practicedAt
+					}
+				}
+			}
+		}
+	}
+
+	children := make(map[string][]domain.Skill)
+	for _, skill := range skills {
+		parent := skill.ParentKey
+		if _, ok := parents[parent]; !ok {
+			parent = ""
+		}
+		children[parent] = append(children[parent], skill)
+	}
+
+	competencies := []domain.SkillCompetency{}
+	var walk func(parent string, level int)
+	walk = func(parent string, level int) {
+		siblings := children[parent]
+		sort.Slice(siblings, func(i, j int) bool { return siblings[i].Name < siblings[j].Name })
+		for _, skill := range siblings {
+			if level > len(skills) {
+				return // a cycle in the taxonomy
+			}
+			if total, ok := totals[skill.Key]; ok {
+				competency := *total
+				competency.Name = skill.Name
+				competency.ParentKey = skill.ParentKey
+				competency.Level = level
+				competency.AverageScore = roundTo(competency.AverageScore/float64(competency.Answers), 1)
+				competency.Rooms = len(roomsBySkill[skill.Key])
+				competencies = append(competencies, competency)
+			}
+			walk(skill.Key, level+1)
+		}
+	}
+	walk("", 0)
+	return competencies
+}
@@ -0,0 +1,110 @@
+package infrastructure
+
+import (
+	"fmt"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// ConversationUnit is one main question of an interview with its follow-ups and
+// every reply of the candidate, graded together as a single answer
+type ConversationUnit struct {
+	Question domain.Message
+	Turns    []domain.Message // follow-ups and replies after the question, in order
+}
+
+// Answers returns the candidate's replies in the unit
+func (u ConversationUnit) Answers() []domain.Message {
+	var answers []domain.Message
+	for _, msg := range u.Turns {
+		if msg.Sender == "user" {
+			answers = append(answers, msg)
+		}
+	}
+	return answers
+}
+
+// AnswerText joins the candidate's replies in the unit
+func (u ConversationUnit) AnswerText() string {
+	var texts []string
+	for _, answer := range u.Answers() {
+		texts = append(texts, answer.Text)
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+// Exchange renders what followed the main question, labelling follow-ups so the
+// grader can tell them apart from the candidate's replies. A unit with a single
+// reply renders as just that reply.
+func (u ConversationUnit) Exchange() string {
+	if len(u.Turns) == 1 {
+		return u.Turns[0].Text
+	}
+
+	var exchange strings.Builder
+	for _, msg := range u.Turns {
+		label := "Candidate"
+		if msg.Sender != "user" {
+			label = "Interviewer follow-up"
+		}
+		exchange.WriteString(fmt.Sprintf("%s: %s\n", label, msg.Text))
+	}
+	return exchange.String()
+}
+
+// SegmentConversation groups the interview messages of a room into units. A
+// question opens a unit, follow-ups and replies join the open unit, and
+// greetings and closing remarks are not part of any unit. Several messages in a
+// row from the same sender are handled like any other: consecutive replies all
+// join the open unit. Units the candidate never replied to are left out.
+//
+// Messages from before interviewer turns were tagged have no type; each of them
+// opens a unit, except an opening message without a question mark, which is
+// taken to be a greeting.
+func SegmentConversation(messages []domain.Message) []ConversationUnit {
+	var units []ConversationUnit
+	var open *ConversationUnit
+
+	closeUnit := func() {
+		if open != nil && len(open.Answers()) > 0 {
+			units = append(units, *open)
+		}
+		open = nil
+	}
+
+	interviewerTurns := 0
+	for _, msg := range InterviewMessages(messages) {
+		if msg.Sender == "user" {
+			if open != nil {
+				open.Turns = append(open.Turns, msg)
+			}
+			continue
+		}
+
+		interviewerTurns++
+		switch msg.Type {
+		case domain.MessageTypeFollowUp:
+			if open != nil {
+				open.Turns = append(open.Turns, msg)
+				continue
+			}
+			// A follow-up without a question to follow is treated as a question
+			open = &ConversationUnit{Question: msg}
+		case domain.MessageTypeClosing:
+			closeUnit()
+		case domain.MessageTypeQuestion:
+			closeUnit()
+			open = &ConversationUnit{Question: msg}
+		default:
+			closeUnit()
+			if interviewerTurns == 1 && !strings.Contains(msg.Text, "?") {
+				continue
+			}
+			open = &ConversationUnit{Question: msg}
+		}
+	}
+	closeUnit()
+
+	return units
+}
@@ -0,0 +1,148 @@
+package infrastructure
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// LongPauseSeconds is the silence between two words counted as a long pause
+const LongPauseSeconds = 2.0
+
+// fillerPhrases are matched against normalised transcript words, longest first
+var fillerPhrases = [][]string{
+	{"you", "know"},
+	{"i", "mean"},
+	{"sort", "of"},
+	{"kind", "of"},
+	{"um"},
+	{"umm"},
+	{"uh"},
+	{"uhh"},
+	{"er"},
+	{"erm"},
+	{"ah"},
+	{"hmm"},
+	{"like"},
+	{"basically"},
+	{"literally"},
+	{"actually"},
+}
+
+// positionalFillers are ordinary words as well, so they are only counted when they are
+// set off from the sentence: at the start of it or next to a comma ("I, like, ...")
+var positionalFillers = map[string]bool{
+	"like":      true,
+	"basically": true,
+	"literally": true,
+	"actually":  true,
+}
+
+// setOff reports whether the raw word at index i stands apart from the words around it
+func setOff(raw []string, i int) bool {
+	if i == 0 || strings.HasSuffix(raw[i], ",") {
+		return true
+	}
+	return strings.ContainsAny(raw[i-1][len(raw[i-1])-1:], ",.?!")
+}
+
+func normalizeWord(word string) string {
+	return strings.TrimFunc(strings.ToLower(word), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\''
+	})
+}
+
+// ComputeDeliveryMetrics computes pace, filler words and pauses from a transcript with word timestamps
+func ComputeDeliveryMetrics(transcript domain.Transcript) domain.DeliveryMetrics {
+	metrics := domain.DeliveryMetrics{
+		FillerWords: map[string]int{},
+		AnswerCount: 1,
+	}
+
+	words := make([]string, 0, len(transcript.Words))
+	raw := make([]string, 0, len(transcript.Words))
+	for _, word := range transcript.Words {
+		if normalized := normalizeWord(word.Word); normalized != "" {
+			words = append(words, normalized)
+			raw = append(raw, strings.TrimSpace(word.Word))
+		}
+	}
+	metrics.WordCount = len(words)
+
+	for i := 0; i < len(words); {
+		matched := false
+		for _, phrase := range fillerPhrases {
+			if i+len(phrase) > len(words) {
+				continue
+			}
+			if len(phrase) == 1 && positionalFillers[phrase[0]] && !setOff(raw, i) {
+				continue
+			}
+			if strings.Join(words[i:i+len(phrase)], " ") == strings.Join(phrase, " ") {
+				metrics.FillerWords[strings.Join(phrase, " ")]++
+				metrics.FillerWordCount++
+				i += len(phrase)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			i++
+		}
+	}
+
+	for i := 1; i < len(transcript.Words); i++ {
+		pause := transcript.Words[i].Start - transcript.Words[i-1].End
+		if pause >= LongPauseSeconds {
+			metrics.LongPauseCount++
+		}
+		metrics.LongestPauseSeconds = math.Max(metrics.LongestPauseSeconds, pause)
+	}
+
+	metrics.DurationSeconds = transcript.Duration
+	if metrics.DurationSeconds == 0 && len(transcript.Words) > 0 {
+		metrics.DurationSeconds = transcript.Words[len(transcript.Words)-1].End - transcript.Words[0].Start
+	}
+	if metrics.DurationSeconds > 0 {
+		metrics.WordsPerMinute = roundTo(float64(metrics.WordCount)/metrics.DurationSeconds*60, 1)
+	}
+	metrics.DurationSeconds = roundTo(metrics.DurationSeconds, 2)
+	metrics.LongestPauseSeconds = roundTo(metrics.LongestPauseSeconds, 2)
+
+	return metrics
+}
+
+// AggregateDeliveryMetrics combines the metrics of several recordings.
+// Pace is recomputed from the total words and duration rather than averaged.
+// It returns nil when there is nothing to aggregate.
+func AggregateDeliveryMetrics(metrics []domain.DeliveryMetrics) *domain.DeliveryMetrics {
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	total := domain.DeliveryMetrics{FillerWords: map[string]int{}}
+	for _, m := range metrics {
+		total.WordCount += m.WordCount
+		total.DurationSeconds += m.DurationSeconds
+		total.FillerWordCount += m.FillerWordCount
+		total.LongPauseCount += m.LongPauseCount
+		total.LongestPauseSeconds = math.Max(total.LongestPauseSeconds, m.LongestPauseSeconds)
+		total.AnswerCount += m.AnswerCount
+		for word, count := range m.FillerWords {
+			total.FillerWords[word] += count
+		}
+	}
+
+	if total.DurationSeconds > 0 {
+		total.WordsPerMinute = roundTo(float64(total.WordCount)/total.DurationSeconds*60, 1)
+	}
+	total.DurationSeconds = roundTo(total.DurationSeconds, 2)
+	return &total
+}
+
+func roundTo(value float64, decimals int) float64 {
+	factor := math.Pow(10, float64(decimals))
+	return math.Round(value*factor) / factor
+}
@@ -0,0 +1,324 @@
+package infrastructure
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// ExtractDocumentText extracts plain text from a PDF, DOCX or plain text document.
+// The format is detected from the file extension, falling back to the content.
+func ExtractDocumentText(filename string, content []byte) (string, error) {
+	var text string
+	var err error
+
+	switch detectDocumentFormat(filename, content) {
+	case "pdf":
+		text, err = extractPDFText(content)
+	case "docx":
+		text, err = extractDOCXText(content)
+	case "text":
+		if !utf8.Valid(content) {
+			return "", fmt.Errorf("text document is not valid UTF-8")
+		}
+		text = string(content)
+	default:
+		return "", fmt.Errorf("unsupported document format, upload a PDF, DOCX or plain text file")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	text = normalizeWhitespace(text)
+	if text == "" {
+		return "", fmt.Errorf("no text could be extracted from the document")
+	}
+	return text, nil
+}
+
+func detectDocumentFormat(filename string, content []byte) string {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".pdf":
+		return "pdf"
+	case ".docx":
+		return "docx"
+	case ".txt", ".md", ".text":
+		return "text"
+	}
+
+	if bytes.HasPrefix(content, []byte("%PDF")) {
+		return "pdf"
+	}
+	if strings.HasPrefix(http.DetectContentType(content), "text/plain") {
+		return "text"
+	}
+	return ""
+}
+
+// maxInflatedBytes caps how much a document may decompress to, so a small upload cannot
+// inflate into gigabytes. It is shared by all the compressed parts of one document.
+const maxInflatedBytes = 32 << 20
+
+var errDocumentTooLarge = fmt.Errorf("document expands to more than %d MB of data", maxInflatedBytes>>20)
+
+// inflateBudget is a reader that fails once its document used up the inflation cap
+type inflateBudget struct {
+	reader    io.Reader
+	remaining *int64
+}
+
+func (b inflateBudget) Read(p []byte) (int, error) {
+	if *b.remaining <= 0 {
+		// Content that ends right at the cap is fine; only more content is too much
+		var probe [1]byte
+		n, err := b.reader.Read(probe[:])
+		if n > 0 {
+			return 0, errDocumentTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(p)) > *b.remaining {
+		p = p[:*b.remaining]
+	}
+	n, err := b.reader.Read(p)
+	*b.remaining -= int64(n)
+	return n, err
+}
+
+var blankLines = regexp.MustCompile(`\n\s*\n+`)
+var repeatedSpaces = regexp.MustCompile(`[ \t]+`)
+
+func normalizeWhitespace(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = repeatedSpaces.ReplaceAllString(text, " ")
+	text = blankLines.ReplaceAllString(text, "\n\n")
+	return strings.TrimSpace(text)
+}
+
+// extractDOCXText reads the paragraphs of word/document.xml
+func extractDOCXText(content []byte) (string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", fmt.Errorf("failed to open DOCX document: %v", err)
+	}
+
+	for _, file := range archive.File {
+		if file.Name != "word/document.xml" {
+			continue
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return "", fmt.Errorf("failed to read DOCX document: %v", err)
+		}
+		defer reader.Close()
+
+		remaining := int64(maxInflatedBytes)
+		var text strings.Builder
+		inText := false
+		decoder := xml.NewDecoder(inflateBudget{reader: reader, remaining: &remaining})
+		for {
+			token, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			if err == errDocumentTooLarge {
+				return "", errDocumentTooLarge
+			}
+			if err != nil {
+				return "", fmt.Errorf("failed to parse DOCX document: %v", err)
+			}
+
+			switch element := token.(type) {
+			case xml.StartElement:
+				switch element.Name.Local {
+				case "t":
+					inText = true
+				case "tab":
+					text.WriteString("\t")
+				case "br":
+					text.WriteString("\n")
+				}
+			case xml.EndElement:
+				switch element.Name.Local {
+				case "t":
+					inText = false
+				case "p":
+					text.WriteString("\n")
+				}
+			case xml.CharData:
+				if inText {
+					text.Write(element)
+				}
+			}
+		}
+		return text.String(), nil
+	}
+
+	return "", fmt.Errorf("DOCX document has no word/document.xml")
+}
+
+var pdfStream = regexp.MustCompile(`(?s)<<(.*?)>>\s*stream\r?\n`)
+
+// extractPDFText is a small PDF text extractor that reads the text showing
+// operators of every (optionally Flate compressed) content stream. It does not
+// handle custom font encodings, so text from such PDFs may be incomplete.
+func extractPDFText(content []byte) (string, error) {
+	if !bytes.HasPrefix(content, []byte("%PDF")) {
+		return "", fmt.Errorf("file is not a PDF document")
+	}
+
+	remaining := int64(maxInflatedBytes)
+	var text strings.Builder
+	for _, match := range pdfStream.FindAllSubmatchIndex(content, -1) {
+		dictionary := content[match[2]:match[3]]
+		start := match[1]
+		end := bytes.Index(content[start:], []byte("endstream"))
+		if end == -1 {
+			break
+		}
+		data := content[start : start+end]
+
+		if bytes.Contains(dictionary, []byte("/FlateDecode")) {
+			reader, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				continue
+			}
+			// Truncated streams still yield useful text, so keep what was inflated
+			data, err = io.ReadAll(inflateBudget{reader: reader, remaining: &remaining})
+			reader.Close()
+			if err == errDocumentTooLarge {
+				return "", err
+			}
+		} else if bytes.Contains(dictionary, []byte("/Filter")) {
+			// Other filters (images, fonts) never hold page text we can read
+			continue
+		}
+
+		text.WriteString(pdfContentText(data))
+	}
+
+	return text.String(), nil
+}
+
+// pdfContentText collects the strings shown by Tj, TJ, ' and " in a content stream
+func pdfContentText(data []byte) string {
+	var text strings.Builder
+	var pending []string
+
+	for i := 0; i < len(data); i++ {
+		switch ch := data[i]; {
+		case ch == '(':
+			value, next := readPDFLiteral(data, i)
+			pending = append(pending, value)
+			i = next
+		case ch == '%':
+			for i < len(data) && data[i] != '\n' && data[i] != '\r' {
+				i++
+			}
+		case isPDFOperatorChar(ch):
+			start := i
+			for i < len(data) && isPDFOperatorChar(data[i]) {
+				i++
+			}
+			operator := string(data[start:i])
+			i--
+
+			switch operator {
+			case "Tj", "TJ":
+				text.WriteString(strings.Join(pending, ""))
+				pending = nil
+			case "'", "\"":
+				text.WriteString("\n" + strings.Join(pending, ""))
+				pending = nil
+			case "Td", "TD", "T*", "ET":
+				text.WriteString("\n")
+			case "BT":
+				pending = nil
+			}
+		}
+	}
+
+	return text.String()
+}
+
+func isPDFOperatorChar(ch byte) bool {
+	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || ch == '*' || ch == '\'' || ch == '"'
+}
+
+// readPDFLiteral reads a (string) starting at data[start] and returns it with the index of the closing parenthesis
+func readPDFLiteral(data []byte, start int) (string, int) {
+	var value bytes.Buffer
+	depth := 0
+
+	for i := start; i < len(data); i++ {
+		ch := data[i]
+		switch {
+		case ch == '\\' && i+1 < len(data):
+			i++
+			switch escaped := data[i]; escaped {
+			case 'n':
+				value.WriteByte('\n')
+			case 'r':
+				value.WriteByte('\r')
+			case 't':
+				value.WriteByte('\t')
+			case 'b', 'f':
+			case '\r', '\n':
+				// Line continuation
+			default:
+				if escaped >= '0' && escaped <= '7' {
+					octal := int(escaped - '0')
+					for digits := 1; digits < 3 && i+1 < len(data) && data[i+1] >= '0' && data[i+1] <= '7'; digits++ {
+						i++
+						octal = octal*8 + int(data[i]-'0')
+					}
+					value.WriteByte(byte(octal))
+				} else {
+					value.WriteByte(escaped)
+				}
+			}
+		case ch == '(':
+			if depth > 0 {
+				value.WriteByte(ch)
+			}
+			depth++
+		case ch == ')':
+			depth--
+			if depth == 0 {
+				return pdfStringToUTF8(value.Bytes()), i
+			}
+			value.WriteByte(ch)
+		default:
+			value.WriteByte(ch)
+		}
+	}
+
+	return pdfStringToUTF8(value.Bytes()), len(data)
+}
+
+// pdfStringToUTF8 decodes PDFDocEncoding (treated as Latin-1) or UTF-16BE strings
+func pdfStringToUTF8(raw []byte) string {
+	if len(raw) >= 2 && raw[0] == 0xFE && raw[1] == 0xFF {
+		units := make([]uint16, 0, len(raw)/2)
+		for i := 2; i+1 < len(raw); i += 2 {
+			units = append(units, uint16(raw[i])<<8|uint16(raw[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+
+	runes := make([]rune, 0, len(raw))
+	for _, b := range raw {
+		runes = append(runes, rune(b))
+	}
+	return string(runes)
+}
@@ -0,0 +1,306 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// ValidateExportFormat checks that a format can be exported
+func ValidateExportFormat(format string) error {
+	switch format {
+	case domain.ExportFormatJSON, domain.ExportFormatMarkdown, domain.ExportFormatHTML, domain.ExportFormatPDF:
+		return nil
+	}
+	return fmt.Errorf("format must be json, markdown, html or pdf")
+}
+
+// exportFileTypes are the file extension and content type of each format
+var exportFileTypes = map[string][2]string{
+	domain.ExportFormatJSON:     {"json", "application/json"},
+	domain.ExportFormatMarkdown: {"md", "text/markdown; charset=utf-8"},
+	domain.ExportFormatHTML:     {"html", "text/html; charset=utf-8"},
+	domain.ExportFormatPDF:      {"pdf", "application/pdf"},
+}
+
+var nonSlugCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slug turns a text into a file name part
+func slug(text string) string {
+	slug := strings.Trim(nonSlugCharacters.ReplaceAllString(strings.ToLower(text), "-"), "-")
+	if len(slug) > 40 {
+		slug = strings.TrimRight(slug[:40], "-")
+	}
+	return slug
+}
+
+// ExportFilename names the export of a room, or of all rooms when room is nil
+func ExportFilename(room *domain.Room, format string, now time.Time) string {
+	extension := exportFileTypes[format][0]
+	if room == nil {
+		return fmt.Sprintf("interviews-%s.%s", now.UTC().Format("2006-01-02"), extension)
+	}
+	name := "interview"
+	if topic := slug(room.Topic); topic != "" {
+		name += "-" + topic
+	}
+	return fmt.Sprintf("%s-%s.%s", name, room.ID.Hex(), extension)
+}
+
+// markdownCell makes text safe for a Markdown table cell
+func markdownCell(text string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(text), " "), "|", "\\|")
+}
+
+// markdownQuote renders text as a block quote, keeping its line breaks
+func markdownQuote(text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// sortedCriteria returns the criteria of a room's criterion scores in name order
+func sortedCriteria(scores map[string]int) []string {
+	criteria := make([]string, 0, len(scores))
+	for criterion := range scores {
+		criteria = append(criteria, criterion)
+	}
+	sort.Strings(criteria)
+	return criteria
+}
+
+// roomScoreLine describes the score of a report
+func roomScoreLine(report domain.Report) string {
+	if report.Status != "completed" {
+		return "In progress, not graded yet"
+	}
+	if report.ScoreSource == domain.ScoreSourceCoach {
+		return fmt.Sprintf("%d%% (adjusted by a coach, AI score %d%%)", report.Score, report.AIScore)
+	}
+	return fmt.Sprintf("%d%%", report.Score)
+}
+
+// answerScoreLine describes the score of an answer
+func answerScoreLine(answer domain.ReportAnswer) string {
+	if answer.ScoreSource == domain.ScoreSourceCoach {
+		return fmt.Sprintf("%d%% (coach score, AI score %d%%)", answer.Score, answer.AIScore)
+	}
+	return fmt.Sprintf("%d%%", answer.Score)
+}
+
+// reportDetails are the lines under a report's title
+func reportDetails(report domain.Report) []string {
+	var details []string
+	if report.Candidate != "" {
+		details = append(details, "Candidate: "+report.Candidate)
+	}
+	interview := report.InterviewType + " interview"
+	if report.Difficulty != "" {
+		interview += ", " + report.Difficulty
+	}
+	details = append(details, "Interview: "+interview)
+	if date := reportDate(report.CreatedAt); date != "" {
+		if completed := reportDate(report.CompletedAt); completed != "" {
+			date += " to " + completed
+		}
+		details = append(details, "Date: "+date)
+	}
+	details = append(details, "Score: "+roomScoreLine(report))
+	return details
+}
+
+// writeReportMarkdown renders one report under a heading of the given level
+func writeReportMarkdown(markdown *strings.Builder, report domain.Report, level int) {
+	heading := strings.Repeat("#", level)
+	fmt.Fprintf(markdown, "%s %s\n\n", heading, strings.Join(strings.Fields(ReportTitle(report)), " "))
+	for _, detail := range reportDetails(report) {
+		fmt.Fprintf(markdown, "- %s\n", detail)
+	}
+	markdown.WriteString("\n")
+
+	if len(report.CriterionScores) > 0 {
+		markdown.WriteString("| Criterion | Average |\n| --- | --- |\n")
+		for _, criterion := range sortedCriteria(report.CriterionScores) {
+			fmt.Fprintf(markdown, "| %s | %d%% |\n", markdownCell(criterion), report.CriterionScores[criterion])
+		}
+		markdown.WriteString("\n")
+	}
+
+	fmt.Fprintf(markdown, "%s# Feedback\n\n", heading)
+	if len(report.Answers) == 0 {
+		markdown.WriteString("No graded answers.\n\n")
+	}
+	for i, answer := range report.Answers {
+		fmt.Fprintf(markdown, "%s## Question %d: %s\n\n", heading, i+1, strings.Join(strings.Fields(answer.Question), " "))
+		fmt.Fprintf(markdown, "**Answer**\n\n%s\n\n", markdownQuote(answer.Answer))
+		fmt.Fprintf(markdown, "**Score:** %s\n\n", answerScoreLine(answer))
+		if answer.CoachReason != "" {
+			fmt.Fprintf(markdown, "**Coach:** %s\n\n", answer.CoachReason)
+		}
+		if len(answer.Strengths) > 0 {
+			markdown.WriteString("**Strengths**\n\n")
+			for _, strength := range answer.Strengths {
+				fmt.Fprintf(markdown, "- %s\n", strength)
+			}
+			markdown.WriteString("\n")
+		}
+		if len(answer.ToImprove) > 0 {
+			markdown.WriteString("**To improve**\n\n")
+			for _, improvement := range answer.ToImprove {
+				fmt.Fprintf(markdown, "- %s\n", improvement)
+			}
+			markdown.WriteString("\n")
+		}
+		if len(answer.CriterionScores) > 0 {
+			markdown.WriteString("| Criterion | Score | Comment |\n| --- | --- | --- |\n")
+			for _, criterionScore := range answer.CriterionScores {
+				fmt.Fprintf(markdown, "| %s | %d%% | %s |\n", markdownCell(criterionScore.Criterion), criterionScore.Score, markdownCell(criterionScore.Comment))
+			}
+			markdown.WriteString("\n")
+		}
+		if len(answer.AssistanceUsed) > 0 {
+			fmt.Fprintf(markdown, "_Assistance used: %s (-%d points)_\n\n", strings.Join(answer.AssistanceUsed, ", "), answer.ScorePenalty)
+		}
+	}
+
+	fmt.Fprintf(markdown, "%s# Transcript\n\n", heading)
+	for _, message := range report.Transcript {
+		fmt.Fprintf(markdown, "**%s**\n\n%s\n\n", message.Speaker, markdownQuote(message.Text))
+	}
+}
+
+// RenderReportsMarkdown renders reports as one Markdown document. A single report is
+// the whole document; several reports become sections under the title.
+func RenderReportsMarkdown(title string, reports []domain.Report) []byte {
+	var markdown strings.Builder
+	if len(reports) == 1 {
+		writeReportMarkdown(&markdown, reports[0], 1)
+		return []byte(markdown.String())
+	}
+
+	fmt.Fprintf(&markdown, "# %s\n\n", title)
+	if len(reports) == 0 {
+		markdown.WriteString("No interviews yet.\n")
+	}
+	for i, report := range reports {
+		if i > 0 {
+			markdown.WriteString("---\n\n")
+		}
+		writeReportMarkdown(&markdown, report, 2)
+	}
+	return []byte(markdown.String())
+}
+
+// RenderReportsPDF renders reports as a PDF document, each report starting on a new page
+func RenderReportsPDF(title string, reports []domain.Report) ([]byte, error) {
+	document := newPDFDocument(title)
+	if len(reports) == 0 {
+		document.text("No interviews yet.", pdfFontRegular, 11, 0)
+	}
+	for _, report := range reports {
+		document.pageBreak()
+		document.text(ReportTitle(report), pdfFontBold, 18, 0)
+		document.gap(4)
+		document.text(strings.Join(reportDetails(report), "\n"), pdfFontRegular, 10, 0)
+		for _, criterion := range sortedCriteria(report.CriterionScores) {
+			document.text(fmt.Sprintf("%s: %d%%", criterion, report.CriterionScores[criterion]), pdfFontRegular, 10, 12)
+		}
+
+		document.gap(10)
+		document.text("Feedback", pdfFontBold, 14, 0)
+		if len(report.Answers) == 0 {
+			document.text("No graded answers.", pdfFontRegular, 10, 0)
+		}
+		for i, answer := range report.Answers {
+			document.gap(6)
+			document.text(fmt.Sprintf("Question %d: %s", i+1, answer.Question), pdfFontBold, 11, 0)
+			document.text("Answer", pdfFontBold, 10, 0)
+			document.text(answer.Answer, pdfFontRegular, 10, 12)
+			document.text("Score: "+answerScoreLine(answer), pdfFontBold, 10, 0)
+			if answer.CoachReason != "" {
+				document.text("Coach: "+answer.CoachReason, pdfFontRegular, 10, 0)
+			}
+			if len(answer.Strengths) > 0 {
+				document.text("Strengths", pdfFontBold, 10, 0)
+				for _, strength := range answer.Strengths {
+					document.text("• "+strength, pdfFontRegular, 10, 12)
+				}
+			}
+			if len(answer.ToImprove) > 0 {
+				document.text("To improve", pdfFontBold, 10, 0)
+				for _, improvement := range answer.ToImprove {
+					document.text("• "+improvement, pdfFontRegular, 10, 12)
+				}
+			}
+			for _, criterionScore := range answer.CriterionScores {
+				line := fmt.Sprintf("%s: %d%%", criterionScore.Criterion, criterionScore.Score)
+				if criterionScore.Comment != "" {
+					line += " - " + criterionScore.Comment
+				}
+				document.text(line, pdfFontRegular, 9, 12)
+			}
+			if len(answer.AssistanceUsed) > 0 {
+				document.text(fmt.Sprintf("Assistance used: %s (-%d points)", strings.Join(answer.AssistanceUsed, ", "), answer.ScorePenalty), pdfFontRegular, 9, 0)
+			}
+		}
+
+		document.gap(10)
+		document.text("Transcript", pdfFontBold, 14, 0)
+		for _, message := range report.Transcript {
+			document.gap(4)
+			document.text(message.Speaker, pdfFontBold, 10, 0)
+			document.text(message.Text, pdfFontRegular, 10, 12)
+		}
+	}
+	return document.bytes()
+}
+
+// BuildExportDocument builds the JSON export of rooms with their reports
+func BuildExportDocument(rooms []domain.Room, reports []domain.Report, now time.Time) domain.ExportDocument {
+	document := domain.ExportDocument{
+		SchemaVersion: domain.ExportSchemaVersion,
+		ExportedAt:    now.Unix(),
+		Rooms:         make([]domain.ExportedRoom, len(rooms)),
+	}
+	for i, room := range rooms {
+		document.Rooms[i] = domain.ExportedRoom{RoomID: room.ID.Hex(), Report: reports[i]}
+	}
+	return document
+}
+
+// RenderExport renders the reports of rooms in a format. room names the file when a
+// single room is exported and is nil for an export of all rooms.
+func RenderExport(format string, title string, room *domain.Room, rooms []domain.Room, reports []domain.Report, now time.Time) (domain.ExportFile, error) {
+	if err := ValidateExportFormat(format); err != nil {
+		return domain.ExportFile{}, err
+	}
+
+	var content []byte
+	var err error
+	switch format {
+	case domain.ExportFormatJSON:
+		content, err = json.MarshalIndent(BuildExportDocument(rooms, reports, now), "", "  ")
+	case domain.ExportFormatMarkdown:
+		content = RenderReportsMarkdown(title, reports)
+	case domain.ExportFormatHTML:
+		content, err = RenderReportsHTML(title, reports)
+	case domain.ExportFormatPDF:
+		content, err = RenderReportsPDF(title, reports)
+	}
+	if err != nil {
+		return domain.ExportFile{}, err
+	}
+
+	return domain.ExportFile{
+		Filename:    ExportFilename(room, format, now),
+		ContentType: exportFileTypes[format][1],
+		Content:     content,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package infrastructure
+
+// ExportJSONSchema is the JSON Schema of domain.ExportDocument, the JSON export format.
+// It is served at GET /exports/schema; keep it in step with the domain types and bump
+// domain.ExportSchemaVersion when a field changes meaning or is removed.
+const ExportJSONSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$id": "interview-coach/export/v1",
+  "title": "Interview export",
+  "description": "Transcripts, per-question feedback and scores of one or more interview rooms.",
+  "type": "object",
+  "required": ["schema_version", "exported_at", "rooms"],
+  "properties": {
+    "schema_version": {"const": 1, "description": "Version of this schema."},
+    "exported_at": {"type": "integer", "description": "Unix time of the export, in seconds."},
+    "rooms": {"type": "array", "items": {"$ref": "#/$defs/room"}}
+  },
+  "$defs": {
+    "score": {"type": "integer", "minimum": 0, "maximum": 100, "description": "Score in percent."},
+    "score_source": {"enum": ["ai", "coach"], "description": "ai: the AI grader's score. coach: a coach overrode the AI score."},
+    "room": {
+      "type": "object",
+      "required": ["room_id", "role", "topic", "interview_type", "status", "created_at", "score", "ai_score", "score_source", "transcript", "answers"],
+      "properties": {
+        "room_id": {"type": "string", "description": "ID of the room, 24 hexadecimal characters."},
+        "candidate": {"type": "string", "description": "Username of the candidate."},
+        "role": {"type": "string"},
+        "topic": {"type": "string"},
+        "interview_type": {"type": "string", "description": "general, behavioral, system_design, coding or case."},
+        "mode": {"type": "string", "description": "question_bank or review; absent for improvised interviews."},
+        "difficulty": {"type": "string"},
+        "status": {"type": "string", "description": "completed once the room was graded; other rooms have no answers and a score of 0."},
+        "created_at": {"type": "integer", "description": "Unix time in seconds."},
+        "completed_at": {"type": "integer", "description": "Unix time in seconds."},
+        "score": {"$ref": "#/$defs/score", "description": "Authoritative room score."},
+        "ai_score": {"$ref": "#/$defs/score", "description": "Room score of the AI grader."},
+        "score_source": {"$ref": "#/$defs/score_source"},
+        "criterion_scores": {"type": "object", "additionalProperties": {"$ref": "#/$defs/score"}, "description": "Average score of each rubric criterion over the answers."},
+        "transcript": {"type": "array", "items": {"$ref": "#/$defs/message"}},
+        "answers": {"type": "array", "items": {"$ref": "#/$defs/answer"}}
+      }
+    },
+    "message": {
+      "type": "object",
+      "required": ["speaker", "text", "timestamp"],
+      "properties": {
+        "speaker": {"type": "string", "description": "Candidate, Interviewer, Interviewer (persona name), Hint or Model answer."},
+        "text": {"type": "string"},
+        "timestamp": {"type": "integer", "description": "Unix time in seconds."}
+      }
+    },
+    "answer": {
+      "type": "object",
+      "required": ["question", "answer", "score", "ai_score", "score_source", "strengths", "to_improve"],
+      "properties": {
+        "question": {"type": "string"},
+        "answer": {"type": "string", "description": "Every reply of the candidate to the question."},
+        "score": {"$ref": "#/$defs/score", "description": "Authoritative answer score."},
+        "ai_score": {"$ref": "#/$defs/score", "description": "Score of the AI grader after the assistance penalty."},
+        "score_source": {"$ref": "#/$defs/score_source"},
+        "coach_reason": {"type": "string", "description": "Why the coach overrode the AI score."},
+        "strengths": {"type": "array", "items": {"type": "string"}},
+        "to_improve": {"type": "array", "items": {"type": "string"}},
+        "criterion_scores": {"type": "array", "items": {"$ref": "#/$defs/criterion_score"}},
+        "assistance_used": {"type": "array", "items": {"enum": ["hint", "model_answer"]}},
+        "score_penalty": {"type": "integer", "minimum": 0, "description": "Points deducted for the assistance used."}
+      }
+    },
+    "criterion_score": {
+      "type": "object",
+      "required": ["criterion", "score", "weight"],
+      "properties": {
+        "criterion": {"type": "string", "description": "Key of the rubric criterion."},
+        "score": {"$ref": "#/$defs/score"},
+        "weight": {"type": "number", "description": "Weight of the criterion in the answer score."},
+        "evidence": {"type": ["array", "null"], "items": {"type": "string"}, "description": "Quotes from the answer that support the score."},
+        "comment": {"type": "string"}
+      }
+    }
+  }
+}`
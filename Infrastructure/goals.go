@@ -0,0 +1,216 @@
+package infrastructure
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// recentScoreRooms is how many of the latest matching rooms a score goal averages
+const recentScoreRooms = 3
+
+// completedAt is when a room was completed; rooms completed before the time was
+// recorded fall back to their creation time
+func completedAt(room domain.Room) int64 {
+	if room.CompletedAt != 0 {
+		return room.CompletedAt
+	}
+	return room.CreatedAt
+}
+
+// dayOf truncates a Unix time to its UTC day
+func dayOf(timestamp int64) time.Time {
+	t := time.Unix(timestamp, 0).UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// WeekStart returns the Monday, in UTC, of the week containing now
+func WeekStart(now time.Time) time.Time {
+	day := dayOf(now.Unix())
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+// ComputeStreak counts the consecutive days with a completed room. The current streak
+// is still alive when the last practice was yesterday.
+func ComputeStreak(rooms []domain.Room, now time.Time) domain.Streak {
+	days := make(map[time.Time]bool)
+	var streak domain.Streak
+	for _, room := range rooms {
+		if room.Status != "completed" {
+			continue
+		}
+		days[dayOf(completedAt(room))] = true
+		if completedAt(room) > streak.LastPracticedAt {
+			streak.LastPracticedAt = completedAt(room)
+		}
+	}
+
+	sorted := make([]time.Time, 0, len(days))
+	for day := range days {
+		sorted = append(sorted, day)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	run := 0
+	for i, day := range sorted {
+		if i > 0 && sorted[i-1].AddDate(0, 0, 1).Equal(day) {
+			run++
+		} else {
+			run = 1
+		}
+		if run > streak.Longest {
+			streak.Longest = run
+		}
+	}
+
+	today := dayOf(now.Unix())
+	if len(sorted) > 0 {
+		last := sorted[len(sorted)-1]
+		if last.Equal(today) || last.AddDate(0, 0, 1).Equal(today) {
+			streak.Current = run
+		}
+	}
+	return streak
+}
+
+// goalRooms returns the completed rooms that count towards a goal, oldest first
+func goalRooms(goal domain.Goal, rooms []domain.Room) []domain.Room {
+	matching := []domain.Room{}
+	for _, room := range rooms {
+		if room.Status != "completed" {
+			continue
+		}
+		if goal.InterviewType != "" && room.InterviewType != goal.InterviewType {
+			continue
+		}
+		if goal.Topic != "" && !strings.EqualFold(strings.TrimSpace(room.Topic), strings.TrimSpace(goal.Topic)) {
+			continue
+		}
+		matching = append(matching, room)
+	}
+	sort.SliceStable(matching, func(i, j int) bool { return completedAt(matching[i]) < completedAt(matching[j]) })
+	return matching
+}
+
+// EvaluateGoal works out the progress towards a goal
+func EvaluateGoal(goal domain.Goal, rooms []domain.Room, streak domain.Streak, now time.Time) domain.GoalProgress {
+	progress := domain.GoalProgress{Goal: goal}
+	matching := goalRooms(goal, rooms)
+
+	switch goal.Type {
+	case domain.GoalTypeRoomsPerWeek:
+		week := WeekStart(now).Unix()
+		progress.PeriodStart = week
+		for _, room := range matching {
+			if completedAt(room) >= week {
+				progress.Current++
+			}
+		}
+	case domain.GoalTypeReachScore:
+		if len(matching) > recentScoreRooms {
+			matching = matching[len(matching)-recentScoreRooms:]
+		}
+		if len(matching) > 0 {
+			var total int64
+			for _, room := range matching {
+				total += room.PerformancePercentage
+			}
+			progress.Current = int(total / int64(len(matching)))
+		}
+	case domain.GoalTypeStreak:
+		progress.Current = streak.Current
+	}
+
+	if goal.Target > 0 {
+		progress.Percent = min(100, progress.Current*100/goal.Target)
+	}
+	progress.Met = goal.Status == domain.GoalStatusAchieved || progress.Current >= goal.Target
+	return progress
+}
+
+// DescribeGoal is a short sentence for a goal, used in events
+func DescribeGoal(goal domain.Goal) string {
+	var scope string
+	if goal.InterviewType != "" {
+		scope += " " + strings.ReplaceAll(goal.InterviewType, "_", " ")
+	}
+	if goal.Topic != "" {
+		scope += " " + goal.Topic
+	}
+
+	switch goal.Type {
+	case domain.GoalTypeRoomsPerWeek:
+		return fmt.Sprintf("complete %d%s interviews this week", goal.Target, scope)
+	case domain.GoalTypeReachScore:
+		return fmt.Sprintf("reach %d%% on%s interviews", goal.Target, scope)
+	case domain.GoalTypeStreak:
+		return fmt.Sprintf("practice %d days in a row", goal.Target)
+	}
+	return goal.Type
+}
+
+// PracticeStats are the numbers badge rules look at
+type PracticeStats struct {
+	CompletedRooms int
+	LongestStreak  int
+	BestScore      int
+	InterviewTypes int // distinct interview types completed
+	GoalsMet       int
+}
+
+// BuildPracticeStats summarises a user's completed rooms and goals
+func BuildPracticeStats(rooms []domain.Room, streak domain.Streak, goals []domain.Goal) PracticeStats {
+	stats := PracticeStats{LongestStreak: streak.Longest}
+	types := make(map[string]bool)
+	for _, room := range rooms {
+		if room.Status != "completed" {
+			continue
+		}
+		stats.CompletedRooms++
+		stats.BestScore = max(stats.BestScore, int(room.PerformancePercentage))
+		interviewType := room.InterviewType
+		if interviewType == "" {
+			interviewType = domain.InterviewTypeGeneral
+		}
+		types[interviewType] = true
+	}
+	stats.InterviewTypes = len(types)
+	for _, goal := range goals {
+		stats.GoalsMet += goal.TimesMet
+	}
+	return stats
+}
+
+// BadgeRule awards a badge once the metric reaches the threshold
+type BadgeRule struct {
+	Badge     domain.Badge
+	Metric    func(PracticeStats) int
+	Threshold int
+}
+
+func completedRooms(stats PracticeStats) int { return stats.CompletedRooms }
+func longestStreak(stats PracticeStats) int  { return stats.LongestStreak }
+func bestScore(stats PracticeStats) int      { return stats.BestScore }
+func interviewTypes(stats PracticeStats) int { return stats.InterviewTypes }
+func goalsMet(stats PracticeStats) int       { return stats.GoalsMet }
+
+// badgeRules are the achievements users can earn, in display order
+var badgeRules = []BadgeRule{
+	{Badge: domain.Badge{Key: "first_interview", Name: "First steps", Description: "Complete your first interview"}, Metric: completedRooms, Threshold: 1},
+	{Badge: domain.Badge{Key: "ten_interviews", Name: "Regular", Description: "Complete 10 interviews"}, Metric: completedRooms, Threshold: 10},
+	{Badge: domain.Badge{Key: "fifty_interviews", Name: "Veteran", Description: "Complete 50 interviews"}, Metric: completedRooms, Threshold: 50},
+	{Badge: domain.Badge{Key: "streak_3", Name: "Warming up", Description: "Practice 3 days in a row"}, Metric: longestStreak, Threshold: 3},
+	{Badge: domain.Badge{Key: "streak_7", Name: "On a roll", Description: "Practice 7 days in a row"}, Metric: longestStreak, Threshold: 7},
+	{Badge: domain.Badge{Key: "streak_30", Name: "Unstoppable", Description: "Practice 30 days in a row"}, Metric: longestStreak, Threshold: 30},
+	{Badge: domain.Badge{Key: "score_90", Name: "High achiever", Description: "Score 90% or more in an interview"}, Metric: bestScore, Threshold: 90},
+	{Badge: domain.Badge{Key: "all_rounder", Name: "All-rounder", Description: "Complete every type of interview"}, Metric: interviewTypes, Threshold: len(defaultInterviewTemplates)},
+	{Badge: domain.Badge{Key: "goal_getter", Name: "Goal getter", Description: "Meet one of your goals"}, Metric: goalsMet, Threshold: 1},
+}
+
+// BadgeRules returns every badge rule
+func BadgeRules() []BadgeRule {
+	return badgeRules
+}
@@ -7,15 +7,39 @@ import (
 	domain "github.com/chachidani/interview-coach-backend/Domain"
 )
 
+// senderLabel names the sender of a message, including the persona in panel rooms
+func senderLabel(msg domain.Message) string {
+	if msg.Sender == "user" {
+		return "User"
+	}
+	switch msg.Type {
+	case domain.MessageTypeHint:
+		return "Hint"
+	case domain.MessageTypeModelAnswer:
+		return "Model answer"
+	}
+	if msg.Persona != "" {
+		return fmt.Sprintf("AI (%s)", msg.Persona)
+	}
+	return "AI"
+}
+
+// ActiveMessages returns the messages that were not retracted
+func ActiveMessages(messages []domain.Message) []domain.Message {
+	active := make([]domain.Message, 0, len(messages))
+	for _, msg := range messages {
+		if !msg.Retracted {
+			active = append(active, msg)
+		}
+	}
+	return active
+}
+
 // BuildMessageHistory builds a formatted message history for a single room
 func BuildMessageHistory(room domain.Room) string {
 	var messageHistory strings.Builder
-	for _, msg := range room.Messages {
-		if msg.Sender == "user" {
-			messageHistory.WriteString(fmt.Sprintf("User: %s\n", msg.Text))
-		} else {
-			messageHistory.WriteString(fmt.Sprintf("AI: %s\n", msg.Text))
-		}
+	for _, msg := range ActiveMessages(room.Messages) {
+		messageHistory.WriteString(fmt.Sprintf("%s: %s\n", senderLabel(msg), msg.Text))
 	}
 	return messageHistory.String()
 }
@@ -33,13 +57,9 @@ func BuildRoomsMessageHistory(rooms []domain.Room) string {
 func BuildRoomMessageHistoryWithTimestamps(room domain.Room) string {
 	var messageHistory strings.Builder
 
-	for _, msg := range room.Messages {
+	for _, msg := range ActiveMessages(room.Messages) {
 		timestamp := fmt.Sprintf("[%d]", msg.Timestamp)
-		if msg.Sender == "user" {
-			messageHistory.WriteString(fmt.Sprintf("%s User: %s\n", timestamp, msg.Text))
-		} else {
-			messageHistory.WriteString(fmt.Sprintf("%s AI: %s\n", timestamp, msg.Text))
-		}
+		messageHistory.WriteString(fmt.Sprintf("%s %s: %s\n", timestamp, senderLabel(msg), msg.Text))
 	}
 
 	return messageHistory.String()
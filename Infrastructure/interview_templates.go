@@ -0,0 +1,187 @@
+package infrastructure
+
+import (
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// defaultFeedbackSchema is the feedback shape every template must at least produce
+const defaultFeedbackSchema = `{
+    "strength": ["strength1", "strength2"],
+    "to_improve": ["improvement1", "improvement2"],
+    "score_percentage": 85
+}`
+
+// defaultRubricCriteria are the criteria of templates that do not define their own
+var defaultRubricCriteria = []domain.RubricCriterion{
+	{Key: domain.CriterionCorrectness, Name: "Correctness", Description: "The answer is accurate and actually addresses the question", Weight: 0.35},
+	{Key: domain.CriterionDepth, Name: "Depth", Description: "The answer goes beyond the surface: reasoning, trade-offs and concrete details", Weight: 0.25},
+	{Key: domain.CriterionCommunication, Name: "Communication", Description: "The answer is clear, concise and easy to follow", Weight: 0.2},
+	{Key: domain.CriterionStructure, Name: "Structure", Description: "The answer is organised logically from start to finish", Weight: 0.2},
+}
+
+// weightedCriteria returns the default criteria with interview type specific weights
+func weightedCriteria(correctness, depth, communication, structure float64) []domain.RubricCriterion {
+	weights := []float64{correctness, depth, communication, structure}
+	criteria := make([]domain.RubricCriterion, len(defaultRubricCriteria))
+	for i, criterion := range defaultRubricCriteria {
+		criterion.Weight = weights[i]
+		criteria[i] = criterion
+	}
+	return criteria
+}
+
+// TemplateCriteria returns the rubric criteria of a template, falling back to the
+// default criteria for templates stored before criteria existed
+func TemplateCriteria(template domain.InterviewTemplate) []domain.RubricCriterion {
+	if len(template.Criteria) > 0 {
+		return template.Criteria
+	}
+	return defaultRubricCriteria
+}
+
+// defaultInterviewTemplates are the built-in version 1 templates for each interview type.
+// They are used until a newer version of the same type is stored in the database.
+var defaultInterviewTemplates = map[string]domain.InterviewTemplate{
+	domain.InterviewTypeGeneral: {
+		Type:    domain.InterviewTypeGeneral,
+		Version: 1,
+		Name:    "General interview",
+		Persona: "You are an AI interviewer.",
+		QuestionFlow: []string{
+			"Greet the candidate and ask an opening question about the topic",
+			"Ask one question at a time and follow up on vague answers",
+		},
+		Rubric: []string{
+			"Correctness of the answer",
+			"Depth of understanding",
+			"Clarity of communication",
+		},
+		Criteria:       weightedCriteria(0.35, 0.25, 0.2, 0.2),
+		FeedbackSchema: defaultFeedbackSchema,
+	},
+	domain.InterviewTypeBehavioral: {
+		Type:    domain.InterviewTypeBehavioral,
+		Version: 1,
+		Name:    "Behavioral interview",
+		Persona: "You are an experienced hiring manager running a behavioral interview. You are warm but you insist on concrete examples from the candidate's past.",
+		QuestionFlow: []string{
+			"Greet the candidate and ask them to describe a specific past situation relevant to the role",
+			"Use the STAR method: probe for the Situation, the Task, the Actions the candidate personally took and the Result",
+			"If the candidate speaks in generalities, ask for one concrete example",
+			"Ask about what they learned or would do differently",
+		},
+		Rubric: []string{
+			"Answer follows the STAR structure",
+			"Candidate's personal ownership of the actions",
+			"Measurable or clearly described result",
+			"Reflection and learning",
+		},
+		Criteria:       weightedCriteria(0.2, 0.2, 0.25, 0.35),
+		FeedbackSchema: `{
+    "strength": ["strength1", "strength2"],
+    "to_improve": ["improvement1", "improvement2"],
+    "score_percentage": 85
+}`,
+	},
+	domain.InterviewTypeSystemDesign: {
+		Type:    domain.InterviewTypeSystemDesign,
+		Version: 1,
+		Name:    "System design interview",
+		Persona: "You are a senior staff engineer running a system design interview. You are collaborative but you push for numbers and trade-offs.",
+		QuestionFlow: []string{
+			"Greet the candidate and present an open-ended design problem related to the topic",
+			"Ask the candidate to clarify functional and non-functional requirements",
+			"Ask for capacity estimates: traffic, storage and bandwidth",
+			"Ask for a high-level design, then deep dive into one or two components",
+			"Probe bottlenecks, failure modes, scaling and trade-offs",
+		},
+		Rubric: []string{
+			"Requirements gathering",
+			"Capacity estimation",
+			"High-level architecture",
+			"Depth on critical components",
+			"Trade-offs and failure handling",
+		},
+		Criteria:       weightedCriteria(0.3, 0.35, 0.15, 0.2),
+		FeedbackSchema: `{
+    "strength": ["strength1", "strength2"],
+    "to_improve": ["improvement1", "improvement2"],
+    "score_percentage": 85,
+    "capacity_estimates_provided": true,
+    "trade_offs_discussed": ["trade-off1"]
+}`,
+	},
+	domain.InterviewTypeCoding: {
+		Type:    domain.InterviewTypeCoding,
+		Version: 1,
+		Name:    "Coding interview",
+		Persona: "You are a software engineer running a coding interview. You give a problem, let the candidate think aloud and nudge them without giving away the solution.",
+		QuestionFlow: []string{
+			"Greet the candidate and state a coding problem related to the topic with an example input and output",
+			"Ask the candidate to explain their approach before writing code",
+			"Ask about time and space complexity",
+			"Ask about edge cases and how they would test the solution",
+		},
+		Rubric: []string{
+			"Correctness of the solution",
+			"Time and space complexity",
+			"Edge case handling",
+			"Code clarity and communication",
+		},
+		Criteria:       weightedCriteria(0.45, 0.2, 0.15, 0.2),
+		FeedbackSchema: `{
+    "strength": ["strength1", "strength2"],
+    "to_improve": ["improvement1", "improvement2"],
+    "score_percentage": 85,
+    "complexity": "O(n log n) time, O(n) space"
+}`,
+	},
+	domain.InterviewTypeCase: {
+		Type:    domain.InterviewTypeCase,
+		Version: 1,
+		Name:    "Case interview",
+		Persona: "You are a consultant running a case interview. You present a business problem and expect a structured, hypothesis-driven approach.",
+		QuestionFlow: []string{
+			"Greet the candidate and present a business case related to the topic",
+			"Ask the candidate to structure the problem before diving in",
+			"Provide data when asked and request quick quantitative analysis",
+			"Ask for a synthesis and a recommendation",
+		},
+		Rubric: []string{
+			"Problem structuring",
+			"Quantitative reasoning",
+			"Business judgement",
+			"Synthesis and recommendation",
+		},
+		Criteria:       weightedCriteria(0.25, 0.2, 0.2, 0.35),
+		FeedbackSchema: `{
+    "strength": ["strength1", "strength2"],
+    "to_improve": ["improvement1", "improvement2"],
+    "score_percentage": 85,
+    "framework_used": "profitability"
+}`,
+	},
+}
+
+// DefaultInterviewTemplate returns the built-in template for an interview type
+func DefaultInterviewTemplate(interviewType string) (domain.InterviewTemplate, bool) {
+	template, ok := defaultInterviewTemplates[interviewType]
+	return template, ok
+}
+
+// DefaultInterviewTemplates returns all built-in templates
+func DefaultInterviewTemplates() []domain.InterviewTemplate {
+	types := []string{
+		domain.InterviewTypeGeneral,
+		domain.InterviewTypeBehavioral,
+		domain.InterviewTypeSystemDesign,
+		domain.InterviewTypeCoding,
+		domain.InterviewTypeCase,
+	}
+
+	templates := make([]domain.InterviewTemplate, 0, len(types))
+	for _, interviewType := range types {
+		templates = append(templates, defaultInterviewTemplates[interviewType])
+	}
+	return templates
+}
@@ -0,0 +1,14 @@
+package infrastructure
+
+import "strings"
+
+// ExtractJSON returns the JSON object embedded in a model response.
+// Gemini often wraps JSON in markdown code fences or adds a sentence around it.
+func ExtractJSON(response string) string {
+	start := strings.Index(response, "{")
+	end := strings.LastIndex(response, "}")
+	if start == -1 || end < start {
+		return strings.TrimSpace(response)
+	}
+	return response[start : end+1]
+}
@@ -8,10 +8,22 @@ import (
 
 var jwtService *JWTService
 
+// adminEmails holds the lower-cased emails allowed through AdminMiddleware
+var adminEmails = map[string]bool{}
+
 func SetJWTService(service *JWTService) {
 	jwtService = service
 }
 
+func SetAdminEmails(emails []string) {
+	adminEmails = map[string]bool{}
+	for _, email := range emails {
+		if email = strings.ToLower(strings.TrimSpace(email)); email != "" {
+			adminEmails[email] = true
+		}
+	}
+}
+
 func AuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")			
@@ -46,4 +58,18 @@ func AuthMiddleware() gin.HandlerFunc {
 		c.Set("email", claims["email"])
 		c.Next()
 	}
-}	
+}
+
+// AdminMiddleware must run after AuthMiddleware; it only lets configured admin emails through
+func AdminMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		email, _ := c.Get("email")
+		emailStr, _ := email.(string)
+		if !adminEmails[strings.ToLower(emailStr)] {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Admin access required"})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,150 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// MaxOverallFeedbackPromptChars caps the room summaries sent in one overall feedback prompt.
+// Longer histories are condensed in batches first.
+const MaxOverallFeedbackPromptChars = 30000
+
+// maxSummaryItems caps how many strengths or improvements of one answer are summarised
+const maxSummaryItems = 3
+
+// OverallFeedbackResult is the model's answer to the overall feedback prompts.
+// The model only writes the narrative; the numbers come from ComputeOverallMetrics.
+type OverallFeedbackResult struct {
+	Strength    []string `json:"strength"`
+	Improvement []string `json:"improvement"`
+}
+
+// SummarizeRoomFeedback renders the stored feedback of a completed room as a short
+// summary, so overall feedback never needs the room's transcript
+func SummarizeRoomFeedback(room domain.Room) string {
+	var summary strings.Builder
+	summary.WriteString(fmt.Sprintf("Interview on %s for %s (%s), score %d%%", room.Topic, room.Role, room.InterviewType, room.PerformancePercentage))
+	if room.ScoreSource == domain.ScoreSourceCoach {
+		summary.WriteString(fmt.Sprintf(" (set by a coach, AI score %d%%)", room.AIPerformancePercentage))
+	}
+	summary.WriteString("\n")
+	if len(room.CriterionScores) > 0 {
+		var criteria []string
+		for criterion, score := range room.CriterionScores {
+			criteria = append(criteria, fmt.Sprintf("%s %d%%", criterion, score))
+		}
+		sort.Strings(criteria)
+		summary.WriteString(fmt.Sprintf("Criteria: %s\n", strings.Join(criteria, ", ")))
+	}
+	if len(room.Feedback) == 0 {
+		summary.WriteString("No graded answers.\n")
+	}
+	for i, feedback := range room.Feedback {
+		summary.WriteString(fmt.Sprintf("Answer %d (%d%%)", i+1, feedback.ScorePercentage))
+		if feedback.CoachOverride != nil {
+			summary.WriteString(fmt.Sprintf(" coach score %d%%: %s.", feedback.CoachOverride.Score, feedback.CoachOverride.Reason))
+		}
+		if strengths := firstItems(feedback.Strength); strengths != "" {
+			summary.WriteString(fmt.Sprintf(" strengths: %s.", strengths))
+		}
+		if improvements := firstItems(feedback.ToImprove); improvements != "" {
+			summary.WriteString(fmt.Sprintf(" to improve: %s.", improvements))
+		}
+		summary.WriteString("\n")
+	}
+	return summary.String()
+}
+
+func firstItems(items []string) string {
+	if len(items) > maxSummaryItems {
+		items = items[:maxSummaryItems]
+	}
+	return strings.Join(items, "; ")
+}
+
+// ChunkSummaries splits summaries into batches of at most maxChars characters.
+// A single summary longer than maxChars gets a batch of its own, cut to maxChars.
+func ChunkSummaries(summaries []string, maxChars int) []string {
+	var chunks []string
+	var chunk strings.Builder
+	for _, summary := range summaries {
+		if len(summary) > maxChars {
+			summary = summary[:maxChars]
+		}
+		if chunk.Len() > 0 && chunk.Len()+len(summary)+1 > maxChars {
+			chunks = append(chunks, chunk.String())
+			chunk.Reset()
+		}
+		chunk.WriteString(summary)
+		chunk.WriteString("\n")
+	}
+	if chunk.Len() > 0 {
+		chunks = append(chunks, chunk.String())
+	}
+	return chunks
+}
+
+// overallFeedbackSchema is the JSON shape every overall feedback prompt asks for
+const overallFeedbackSchema = `{
+    "strength": ["strength1", "strength2"],
+    "improvement": ["improvement1", "improvement2"]
+}`
+
+// BuildCondenseFeedbackPrompt builds the map step of overall feedback for long histories:
+// one batch of room summaries is condensed into a partial overall feedback
+func BuildCondenseFeedbackPrompt(summaries string) string {
+	return fmt.Sprintf(`You are an AI interviewer. Condense the feedback of the following interview sessions
+into the candidate's recurring strengths and areas for improvement. Keep at most five of each.
+
+Interview sessions:
+%s
+
+Format your response as JSON:
+%s`, summaries, overallFeedbackSchema)
+}
+
+// DescribeCondensedFeedback renders a condensed batch so it can be summarised again
+func DescribeCondensedFeedback(result OverallFeedbackResult) string {
+	return fmt.Sprintf("Batch of interviews\nStrengths: %s\nTo improve: %s\n",
+		strings.Join(result.Strength, "; "), strings.Join(result.Improvement, "; "))
+}
+
+// BuildOverallFeedbackPrompt builds the prompt that updates the previous overall feedback
+// with the summaries of the rooms completed since. previous is nil for a user's first
+// overall feedback.
+func BuildOverallFeedbackPrompt(previous *domain.OverallFeedback, summaries string) string {
+	var context strings.Builder
+	if previous != nil {
+		context.WriteString(fmt.Sprintf("This was the candidate's overall feedback after %d interviews:\n", previous.TotalInterview))
+		context.WriteString(fmt.Sprintf("Strengths: %s\n", strings.Join(previous.Strength, "; ")))
+		context.WriteString(fmt.Sprintf("To improve: %s\n\n", strings.Join(previous.Improvement, "; ")))
+		context.WriteString("Update it with the interviews the candidate completed since. Keep points that still hold, drop improvements the candidate has since shown, and add new ones.\n")
+	} else {
+		context.WriteString("Analyze the following interview sessions and provide comprehensive feedback.\n")
+	}
+
+	return fmt.Sprintf(`You are an AI interviewer providing overall feedback.
+%s
+Interview History:
+%s
+
+Please provide:
+1. List of overall strengths across all interviews
+2. Areas that need improvement
+
+Format your response as JSON:
+%s`, context.String(), summaries, overallFeedbackSchema)
+}
+
+// ParseOverallFeedback parses the model's answer to the overall feedback prompts
+func ParseOverallFeedback(response string) (OverallFeedbackResult, error) {
+	var result OverallFeedbackResult
+	if err := json.Unmarshal([]byte(ExtractJSON(response)), &result); err != nil {
+		return OverallFeedbackResult{}, fmt.Errorf("failed to parse feedback response: %v", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// OverallMetrics are the numbers of an overall feedback, computed from the stored rooms
+type OverallMetrics struct {
+	ScorePercentage   int
+	AverageScore      float64
+	TopicScores       []domain.TopicScore
+	TopTopic          string
+	WeakestTopic      string
+	TotalInterview    int64
+	StartedInterviews int64
+	CompletionRate    float64
+	CoachScoredRooms  int64
+}
+
+// ComputeOverallMetrics aggregates a user's rooms. Scores come from the completed rooms'
+// PerformancePercentage: the average score is their plain mean and each topic's average
+// is the mean over the completed rooms on that topic. Topics are grouped case-insensitively
+// and ties are broken by topic name so the result does not depend on room order.
+// The completion rate is the percentage of started rooms that were completed. Rooms whose
+// score a coach adjusted count with the coach-adjusted score and are counted in CoachScoredRooms.
+func ComputeOverallMetrics(rooms []domain.Room) OverallMetrics {
+	metrics := OverallMetrics{StartedInterviews: int64(len(rooms)), TopicScores: []domain.TopicScore{}}
+
+	totals := make(map[string]int64)
+	topics := make(map[string]*domain.TopicScore)
+	var total int64
+	for _, room := range rooms {
+		if room.Status != "completed" {
+			continue
+		}
+		metrics.TotalInterview++
+		total += room.PerformancePercentage
+		if room.ScoreSource == domain.ScoreSourceCoach {
+			metrics.CoachScoredRooms++
+		}
+
+		key := strings.ToLower(strings.TrimSpace(room.Topic))
+		if topics[key] == nil {
+			topics[key] = &domain.TopicScore{Topic: strings.TrimSpace(room.Topic)}
+		}
+		topics[key].Count++
+		totals[key] += room.PerformancePercentage
+	}
+
+	if metrics.StartedInterviews > 0 {
+		metrics.CompletionRate = roundTo(float64(metrics.TotalInterview)/float64(metrics.StartedInterviews)*100, 1)
+	}
+	if metrics.TotalInterview == 0 {
+		return metrics
+	}
+
+	metrics.AverageScore = roundTo(float64(total)/float64(metrics.TotalInterview), 1)
+	metrics.ScorePercentage = int(math.Round(float64(total) / float64(metrics.TotalInterview)))
+
+	for key, topic := range topics {
+		topic.AverageScore = roundTo(float64(totals[key])/float64(topic.Count), 1)
+		metrics.TopicScores = append(metrics.TopicScores, *topic)
+	}
+	sort.Slice(metrics.TopicScores, func(i, j int) bool {
+		a, b := metrics.TopicScores[i], metrics.TopicScores[j]
+		if a.AverageScore != b.AverageScore {
+			return a.AverageScore > b.AverageScore
+		}
+		return a.Topic < b.Topic
+	})
+
+	metrics.TopTopic = metrics.TopicScores[0].Topic
+	if len(metrics.TopicScores) > 1 {
+		metrics.WeakestTopic = metrics.TopicScores[len(metrics.TopicScores)-1].Topic
+	}
+	return metrics
+}
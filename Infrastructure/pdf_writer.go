@@ -0,0 +1,229 @@
+package infrastructure
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+)
+
+// A4 page in PDF points, with the margins of the text
+const (
+	pdfPageWidth  = 595.28
+	pdfPageHeight = 841.89
+	pdfMargin     = 56.0
+)
+
+// PDF fonts: the standard Helvetica fonts every reader ships, so nothing is embedded
+const (
+	pdfFontRegular = "F1"
+	pdfFontBold    = "F2"
+)
+
+// helveticaWidths are the glyph widths of Helvetica for the characters from ' ' to '~',
+// in thousandths of the font size
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// winAnsiSpecials maps the characters outside Latin-1 that WinAnsiEncoding can show
+var winAnsiSpecials = map[rune]byte{
+	'€': 0x80, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// winAnsi encodes text for the standard fonts. Characters they cannot show become '?'.
+func winAnsi(text string) []byte {
+	encoded := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r == '\t':
+			encoded = append(encoded, ' ')
+		case r == '−':
+			encoded = append(encoded, '-')
+		case r >= 32 && r <= 126, r >= 160 && r <= 255:
+			encoded = append(encoded, byte(r))
+		case winAnsiSpecials[r] != 0:
+			encoded = append(encoded, winAnsiSpecials[r])
+		default:
+			encoded = append(encoded, '?')
+		}
+	}
+	return encoded
+}
+
+// pdfTextWidth estimates the width of encoded text in points. Bold glyphs are at most
+// about a tenth wider than regular ones, so bold text is measured with that margin.
+func pdfTextWidth(text []byte, font string, size float64) float64 {
+	units := 0
+	for _, b := range text {
+		if b >= 32 && b <= 126 {
+			units += helveticaWidths[b-32]
+		} else {
+			units += 556
+		}
+	}
+	width := float64(units) * size / 1000
+	if font == pdfFontBold {
+		width *= 1.1
+	}
+	return width
+}
+
+// pdfLiteral writes encoded text as a PDF string literal
+func pdfLiteral(text []byte) string {
+	var literal strings.Builder
+	literal.WriteByte('(')
+	for _, b := range text {
+		switch {
+		case b == '(' || b == ')' || b == '\\':
+			literal.WriteByte('\\')
+			literal.WriteByte(b)
+		case b >= 128:
+			literal.WriteString(fmt.Sprintf("\\%03o", b))
+		default:
+			literal.WriteByte(b)
+		}
+	}
+	literal.WriteByte(')')
+	return literal.String()
+}
+
+// pdfDocument lays out wrapped text on A4 pages and writes them as a PDF file.
+// It only knows text, which is all reports need.
+type pdfDocument struct {
+	title string
+	pages []*strings.Builder
+	y     float64
+}
+
+func newPDFDocument(title string) *pdfDocument {
+	document := &pdfDocument{title: title}
+	document.newPage()
+	return document
+}
+
+func (d *pdfDocument) newPage() {
+	d.pages = append(d.pages, &strings.Builder{})
+	d.y = pdfPageHeight - pdfMargin
+}
+
+// gap leaves vertical space, starting a new page when the space runs out
+func (d *pdfDocument) gap(points float64) {
+	d.y -= points
+	if d.y < pdfMargin {
+		d.newPage()
+	}
+}
+
+// pageBreak starts a new page unless the current one is still empty
+func (d *pdfDocument) pageBreak() {
+	if d.y < pdfPageHeight-pdfMargin {
+		d.newPage()
+	}
+}
+
+// wrap splits a paragraph into lines that fit the width, breaking words that do not fit on a line of their own
+func wrap(paragraph []byte, font string, size, width float64) [][]byte {
+	var lines [][]byte
+	var line []byte
+	for _, word := range bytes.Fields(paragraph) {
+		candidate := word
+		if len(line) > 0 {
+			candidate = append(append(append([]byte{}, line...), ' '), word...)
+		}
+		if pdfTextWidth(candidate, font, size) <= width {
+			line = candidate
+			continue
+		}
+		if len(line) > 0 {
+			lines = append(lines, line)
+			line = nil
+		}
+		for pdfTextWidth(word, font, size) > width {
+			cut := len(word) - 1
+			for cut > 1 && pdfTextWidth(word[:cut], font, size) > width {
+				cut--
+			}
+			lines = append(lines, word[:cut])
+			word = word[cut:]
+		}
+		line = word
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// text writes wrapped text; every line of the text starts a new paragraph
+func (d *pdfDocument) text(text string, font string, size, indent float64) {
+	leading := size * 1.35
+	width := pdfPageWidth - 2*pdfMargin - indent
+	for _, paragraph := range strings.Split(text, "\n") {
+		lines := wrap(winAnsi(paragraph), font, size, width)
+		if len(lines) == 0 {
+			d.gap(leading / 2)
+			continue
+		}
+		for _, line := range lines {
+			if d.y-leading < pdfMargin {
+				d.newPage()
+			}
+			d.y -= leading
+			fmt.Fprintf(d.pages[len(d.pages)-1], "BT /%s %.1f Tf %.2f %.2f Td %s Tj ET\n", font, size, pdfMargin+indent, d.y, pdfLiteral(line))
+		}
+	}
+}
+
+// bytes writes the document. Page contents are Flate compressed.
+func (d *pdfDocument) bytes() ([]byte, error) {
+	var objects []string
+	add := func(object string) int {
+		objects = append(objects, object)
+		return len(objects)
+	}
+
+	catalog := add("")
+	pages := add("")
+	regular := add("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	bold := add("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	info := add(fmt.Sprintf("<< /Title %s /Producer (interview-coach) >>", pdfLiteral(winAnsi(d.title))))
+
+	var kids []string
+	for _, page := range d.pages {
+		var content bytes.Buffer
+		writer := zlib.NewWriter(&content)
+		if _, err := writer.Write([]byte(page.String())); err != nil {
+			return nil, fmt.Errorf("failed to compress PDF page: %v", err)
+		}
+		if err := writer.Close(); err != nil {
+			return nil, fmt.Errorf("failed to compress PDF page: %v", err)
+		}
+		stream := add(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", content.Len(), content.String()))
+		pageObject := add(fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /%s %d 0 R /%s %d 0 R >> >> /Contents %d 0 R >>",
+			pages, pdfPageWidth, pdfPageHeight, pdfFontRegular, regular, pdfFontBold, bold, stream))
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageObject))
+	}
+	objects[catalog-1] = fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pages)
+	objects[pages-1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids))
+
+	var file bytes.Buffer
+	file.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = file.Len()
+		fmt.Fprintf(&file, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := file.Len()
+	fmt.Fprintf(&file, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&file, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&file, "trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, catalog, info, xref)
+	return file.Bytes(), nil
+}
@@ -0,0 +1,46 @@
+package infrastructure
+
+import (
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// defaultPersonas are available even when the personas collection is empty.
+// A stored persona with the same key replaces the built-in one.
+var defaultPersonas = []domain.Persona{
+	{
+		Key:         domain.PersonaHRScreener,
+		Name:        "Hana",
+		Title:       "HR screener",
+		Description: "Friendly and encouraging. Keeps the conversation light, checks motivation, communication and culture fit, and never goes deep into technical details.",
+		Focus:       []string{"motivation", "communication", "culture fit", "career goals"},
+	},
+	{
+		Key:         domain.PersonaStaffEngineer,
+		Name:        "Sam",
+		Title:       "Staff engineer",
+		Description: "Skeptical and precise. Challenges hand-wavy answers, asks for specifics, trade-offs and evidence, and digs into technical depth.",
+		Focus:       []string{"technical depth", "trade-offs", "design decisions", "debugging"},
+	},
+	{
+		Key:         domain.PersonaHiringManager,
+		Name:        "Morgan",
+		Title:       "Hiring manager",
+		Description: "Direct and pragmatic. Cares about impact, ownership, prioritisation and how the candidate works with a team.",
+		Focus:       []string{"impact", "ownership", "prioritisation", "teamwork"},
+	},
+}
+
+// DefaultPersona returns the built-in persona with the given key
+func DefaultPersona(key string) (domain.Persona, bool) {
+	for _, persona := range defaultPersonas {
+		if persona.Key == key {
+			return persona, true
+		}
+	}
+	return domain.Persona{}, false
+}
+
+// DefaultPersonas returns all built-in personas
+func DefaultPersonas() []domain.Persona {
+	return append([]domain.Persona{}, defaultPersonas...)
+}
@@ -0,0 +1,444 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// BuildGeminiRequest wraps a single text prompt into a Gemini request
+func BuildGeminiRequest(prompt string) domain.GeminiRequest {
+	return domain.GeminiRequest{
+		Contents: []struct {
+			Parts []struct {
+				Text string `json:"text"`
+			} `json:"parts"`
+		}{
+			{
+				Parts: []struct {
+					Text string `json:"text"`
+				}{
+					{Text: prompt},
+				},
+			},
+		},
+	}
+}
+
+// Interviewer is who speaks the next interviewer turn of a room
+type Interviewer struct {
+	Template domain.InterviewTemplate
+	Persona  *domain.Persona  // nil when the room uses the template's own persona
+	Panel    []domain.Persona // all personas of a panel room, including Persona
+}
+
+// describePersona introduces the persona speaking this turn, and the rest of the panel
+func describePersona(interviewer Interviewer) string {
+	if interviewer.Persona == nil {
+		return interviewer.Template.Persona
+	}
+
+	persona := *interviewer.Persona
+	description := fmt.Sprintf("You are %s, the %s, running a %s. %s", persona.Name, persona.Title, interviewer.Template.Name, persona.Description)
+	if len(persona.Focus) > 0 {
+		description += fmt.Sprintf(" You focus on %s.", strings.Join(persona.Focus, ", "))
+	}
+
+	if len(interviewer.Panel) > 1 {
+		var others []string
+		for _, member := range interviewer.Panel {
+			if member.Key != persona.Key {
+				others = append(others, fmt.Sprintf("%s (%s)", member.Name, member.Title))
+			}
+		}
+		description += fmt.Sprintf(" This is a panel interview with %s; it is your turn to speak. Do not repeat what other panelists already asked.", strings.Join(others, ", "))
+	}
+	return description
+}
+
+// buildInterviewerContext describes the interviewer persona, the room and the question flow
+func buildInterviewerContext(interviewer Interviewer, room domain.Room) string {
+	template := interviewer.Template
+
+	var context strings.Builder
+	context.WriteString(fmt.Sprintf("%s The role is %s and the topic is %s.\n", describePersona(interviewer), room.Role, room.Topic))
+
+	if len(template.QuestionFlow) > 0 {
+		context.WriteString("\nFollow this interview flow:\n")
+		for i, step := range template.QuestionFlow {
+			context.WriteString(fmt.Sprintf("%d. %s\n", i+1, step))
+		}
+	}
+
+	if room.Difficulty != "" {
+		context.WriteString(fmt.Sprintf("Pitch your questions at %s difficulty.\n", room.Difficulty))
+	}
+	if len(room.Focus) > 0 {
+		context.WriteString(fmt.Sprintf("The candidate wants to practice %s; ask questions that exercise it.\n", strings.Join(room.Focus, ", ")))
+	}
+
+	if room.JobProfile != nil {
+		context.WriteString("\nThe candidate is preparing for this job. Steer your questions toward its requirements:\n")
+		context.WriteString(describeJobProfile(*room.JobProfile))
+	}
+
+	if room.CandidateProfile != nil {
+		context.WriteString("\nThe candidate's CV is summarised below. Ask about specific projects and roles from it by name, and probe the decisions the candidate made:\n")
+		context.WriteString(describeCandidateProfile(*room.CandidateProfile))
+	}
+
+	return context.String()
+}
+
+// describeCandidateProfile renders a candidate profile as a short bullet list for prompts
+func describeCandidateProfile(profile domain.CandidateProfile) string {
+	var description strings.Builder
+	if profile.Summary != "" {
+		description.WriteString(fmt.Sprintf("Summary: %s\n", profile.Summary))
+	}
+	for _, experience := range profile.Experience {
+		description.WriteString(fmt.Sprintf("- %s at %s (%s)", experience.Title, experience.Company, experience.Duration))
+		if len(experience.Highlights) > 0 {
+			description.WriteString(": " + strings.Join(experience.Highlights, "; "))
+		}
+		description.WriteString("\n")
+	}
+	for _, project := range profile.Projects {
+		description.WriteString(fmt.Sprintf("- Project %s: %s", project.Name, project.Description))
+		if len(project.Technologies) > 0 {
+			description.WriteString(fmt.Sprintf(" [%s]", strings.Join(project.Technologies, ", ")))
+		}
+		description.WriteString("\n")
+	}
+	if len(profile.Skills) > 0 {
+		description.WriteString(fmt.Sprintf("Skills: %s\n", strings.Join(profile.Skills, ", ")))
+	}
+	return description.String()
+}
+
+// maxResumePromptChars caps how much CV text is sent to the model
+const maxResumePromptChars = 20000
+
+// BuildCandidateProfilePrompt builds the prompt that structures the text of a CV
+func BuildCandidateProfilePrompt(resumeText string) string {
+	if len(resumeText) > maxResumePromptChars {
+		resumeText = resumeText[:maxResumePromptChars]
+	}
+
+	return fmt.Sprintf(`You are a technical recruiter. Read the following CV and summarise the candidate.
+
+CV:
+%s
+
+Format your response as JSON:
+{
+    "summary": "one or two sentence summary",
+    "experience": [{"company": "company", "title": "job title", "duration": "2020-2023", "highlights": ["achievement1"]}],
+    "projects": [{"name": "project name", "description": "what it does and the candidate's part", "technologies": ["tech1"]}],
+    "skills": ["skill1", "skill2"]
+}`, resumeText)
+}
+
+// ParseCandidateProfile parses the model's answer to BuildCandidateProfilePrompt
+func ParseCandidateProfile(response string) (domain.CandidateProfile, error) {
+	var profile domain.CandidateProfile
+	if err := json.Unmarshal([]byte(ExtractJSON(response)), &profile); err != nil {
+		return domain.CandidateProfile{}, fmt.Errorf("failed to parse candidate profile: %v", err)
+	}
+	return profile, nil
+}
+
+// describeJobProfile renders a job profile as a short bullet list for prompts
+func describeJobProfile(profile domain.JobProfile) string {
+	var description strings.Builder
+	if profile.Title != "" {
+		description.WriteString(fmt.Sprintf("Job title: %s\n", profile.Title))
+	}
+	if profile.Seniority != "" {
+		description.WriteString(fmt.Sprintf("Seniority: %s\n", profile.Seniority))
+	}
+	if len(profile.RequiredSkills) > 0 {
+		description.WriteString(fmt.Sprintf("Required skills: %s\n", strings.Join(profile.RequiredSkills, ", ")))
+	}
+	if len(profile.Responsibilities) > 0 {
+		description.WriteString(fmt.Sprintf("Responsibilities: %s\n", strings.Join(profile.Responsibilities, "; ")))
+	}
+	if len(profile.NiceToHave) > 0 {
+		description.WriteString(fmt.Sprintf("Nice to have: %s\n", strings.Join(profile.NiceToHave, ", ")))
+	}
+	return description.String()
+}
+
+// BuildJobProfilePrompt builds the prompt that extracts a structured profile from a job description
+func BuildJobProfilePrompt(jobDescription string) string {
+	return fmt.Sprintf(`You are a technical recruiter. Extract the key requirements from the following job description.
+
+Job description:
+%s
+
+Format your response as JSON:
+{
+    "title": "job title",
+    "seniority": "junior, mid, senior, staff or unknown",
+    "required_skills": ["skill1", "skill2"],
+    "responsibilities": ["responsibility1", "responsibility2"],
+    "nice_to_have": ["skill3"]
+}`, jobDescription)
+}
+
+// ParseJobProfile parses the model's answer to BuildJobProfilePrompt
+func ParseJobProfile(response string) (domain.JobProfile, error) {
+	var profile domain.JobProfile
+	if err := json.Unmarshal([]byte(ExtractJSON(response)), &profile); err != nil {
+		return domain.JobProfile{}, fmt.Errorf("failed to parse job profile: %v", err)
+	}
+	return profile, nil
+}
+
+// BuildOpeningPrompt builds the prompt for the first interviewer message of a room
+func BuildOpeningPrompt(interviewer Interviewer, room domain.Room) string {
+	return fmt.Sprintf(`%s
+Please start the interview with a greeting and your first question.`, buildInterviewerContext(interviewer, room))
+}
+
+// BuildFollowUpPrompt builds the prompt for the interviewer's next message
+func BuildFollowUpPrompt(interviewer Interviewer, room domain.Room, messageHistory string) string {
+	return fmt.Sprintf(`%s
+Previous conversation:
+%s
+
+Please provide a relevant follow-up question or response based on the conversation above.
+Either ask a follow-up about the candidate's last answer, move on to a new question, or, once the
+topic has been covered well, thank the candidate and close the interview.
+Use the action %q for a follow-up, %q for a new question and %q to close.
+
+Format your response as JSON:
+{
+    "action": "%s",
+    "text": "your message to the candidate"
+}`,
+		buildInterviewerContext(interviewer, room),
+		messageHistory,
+		InterviewerActionFollowUp, InterviewerActionNextQuestion, InterviewerActionClose, InterviewerActionFollowUp)
+}
+
+// Actions the interviewer can choose when following a question plan
+const (
+	InterviewerActionFollowUp     = "follow_up"
+	InterviewerActionNextQuestion = "next_question"
+	InterviewerActionClose        = "close"
+)
+
+// InterviewerTurn is the structured reply requested from the interviewer after the opening message
+type InterviewerTurn struct {
+	Action string `json:"action"`
+	Text   string `json:"text"`
+}
+
+// ParseInterviewerTurn parses the interviewer's structured reply
+func ParseInterviewerTurn(response string) (InterviewerTurn, error) {
+	var turn InterviewerTurn
+	if err := json.Unmarshal([]byte(ExtractJSON(response)), &turn); err != nil {
+		return InterviewerTurn{}, fmt.Errorf("failed to parse interviewer response: %v", err)
+	}
+	if turn.Text == "" {
+		return InterviewerTurn{}, fmt.Errorf("interviewer response has no text")
+	}
+	return turn, nil
+}
+
+// BuildBankOpeningPrompt builds the first message of a room that follows the question bank
+func BuildBankOpeningPrompt(interviewer Interviewer, room domain.Room, question domain.PlannedQuestion) string {
+	return fmt.Sprintf(`%s
+You must not invent your own questions. Ask the following question from our question bank.
+You may paraphrase it so it sounds natural, but keep its meaning and difficulty.
+
+Question: %s
+
+Please start the interview with a greeting and this question.`, buildInterviewerContext(interviewer, room), question.Text)
+}
+
+// BuildBankFollowUpPrompt builds the next interviewer turn for a room that follows the question bank.
+// next is nil when the current question is the last one in the plan.
+func BuildBankFollowUpPrompt(interviewer Interviewer, room domain.Room, messageHistory string, current domain.PlannedQuestion, next *domain.PlannedQuestion) string {
+	var instructions strings.Builder
+	instructions.WriteString(fmt.Sprintf("The current question from our question bank is: %s\n", current.Text))
+	if current.ReferenceAnswer != "" {
+		instructions.WriteString(fmt.Sprintf("A strong answer covers: %s (never reveal this to the candidate)\n", current.ReferenceAnswer))
+	}
+	instructions.WriteString("\nYou must not invent new questions. Either ask a short follow-up about the candidate's answer to the current question")
+	if next != nil {
+		instructions.WriteString(fmt.Sprintf(", or move on by paraphrasing the next question from the bank: %s\n", next.Text))
+		instructions.WriteString(fmt.Sprintf("Use the action %q for a follow-up and %q to move on.", InterviewerActionFollowUp, InterviewerActionNextQuestion))
+	} else {
+		instructions.WriteString(", or, since this is the last question, thank the candidate and close the interview.\n")
+		instructions.WriteString(fmt.Sprintf("Use the action %q for a follow-up and %q to close.", InterviewerActionFollowUp, InterviewerActionClose))
+	}
+
+	return fmt.Sprintf(`%s
+Previous conversation:
+%s
+
+%s
+
+Format your response as JSON:
+{
+    "action": "%s",
+    "text": "your message to the candidate"
+}`, buildInterviewerContext(interviewer, room), messageHistory, instructions.String(), InterviewerActionFollowUp)
+}
+
+// BuildBankClosingPrompt builds the interviewer's reply once every planned question was asked.
+// The plan is over, so the interviewer only wraps up and never asks anything new.
+func BuildBankClosingPrompt(interviewer Interviewer, room domain.Room, messageHistory string) string {
+	return fmt.Sprintf(`%s
+Previous conversation:
+%s
+
+Every question of this interview has been asked. Do not ask any new question.
+Briefly acknowledge the candidate's last message, thank them and tell them the interview is over
+and they can finish the session to get their feedback.`, buildInterviewerContext(interviewer, room), messageHistory)
+}
+
+// buildCoachContext describes the practice session and the question the candidate needs help with
+func buildCoachContext(template domain.InterviewTemplate, room domain.Room, messageHistory, question string) string {
+	return fmt.Sprintf(`You are an interview coach helping a candidate practice for a %s. The role is %s and the topic is %s.
+
+Conversation so far:
+%s
+The candidate has not answered this question yet: %s
+`, template.Name, room.Role, room.Topic, messageHistory, question)
+}
+
+// BuildHintPrompt builds the prompt for a hint on the question the candidate is answering.
+// planned is set when the question came from the question bank.
+func BuildHintPrompt(template domain.InterviewTemplate, room domain.Room, messageHistory, question string, planned *domain.PlannedQuestion) string {
+	var reference string
+	if planned != nil && planned.ReferenceAnswer != "" {
+		reference = fmt.Sprintf("A strong answer covers: %s (use this to aim the hint, but never reveal it)\n", planned.ReferenceAnswer)
+	}
+
+	return fmt.Sprintf(`%s%s
+Give the candidate one short hint that points them in the right direction without giving the answer away.
+If earlier hints were given for this question, go one step further than the last one instead of repeating it.
+Reply with the hint only.`, buildCoachContext(template, room, messageHistory, question), reference)
+}
+
+// BuildModelAnswerPrompt builds the prompt for a strong answer to the question the candidate is answering.
+// planned is set when the question came from the question bank.
+func BuildModelAnswerPrompt(template domain.InterviewTemplate, room domain.Room, messageHistory, question string, planned *domain.PlannedQuestion) string {
+	var guidance strings.Builder
+	for _, criterion := range template.Rubric {
+		guidance.WriteString(fmt.Sprintf("- %s\n", criterion))
+	}
+	if planned != nil && planned.ReferenceAnswer != "" {
+		guidance.WriteString(fmt.Sprintf("\nReference answer: %s\n", planned.ReferenceAnswer))
+	}
+
+	return fmt.Sprintf(`%s
+Write a strong answer to this question, as the candidate would say it in the interview.
+It should score highly against this rubric:
+%s
+Reply with the answer only.`, buildCoachContext(template, room, messageHistory, question), guidance.String())
+}
+
+// BuildSTARPrompt builds the prompt that analyses a behavioral answer with the STAR method
+func BuildSTARPrompt(question, answer string) string {
+	return fmt.Sprintf(`You are an interview coach analysing a behavioral interview answer with the STAR method
+(Situation, Task, Action, Result).
+
+Question: %s
+Answer: %s
+
+For each component say whether the answer covers it, quote the part of the answer that does,
+and comment on what is missing or could be stronger. A component the answer only hints at is not covered.
+Then rewrite the answer as an exemplar that covers all four components. Keep the candidate's own
+story and facts; where a component is missing, mark what they should add in [square brackets]
+instead of inventing details.
+
+Format your response as JSON:
+{
+    "situation": {"covered": true, "quote": "part of the answer", "comment": "comment"},
+    "task": {"covered": true, "quote": "part of the answer", "comment": "comment"},
+    "action": {"covered": true, "quote": "part of the answer", "comment": "comment"},
+    "result": {"covered": false, "quote": "", "comment": "comment"},
+    "exemplar_answer": "rewritten answer"
+}`, question, answer)
+}
+
+// ParseSTARAnalysis parses the model's answer to BuildSTARPrompt. Missing is derived
+// from the coverage flags rather than trusted from the model.
+func ParseSTARAnalysis(response string) (domain.STARAnalysis, error) {
+	var analysis domain.STARAnalysis
+	if err := json.Unmarshal([]byte(ExtractJSON(response)), &analysis); err != nil {
+		return domain.STARAnalysis{}, fmt.Errorf("failed to parse STAR analysis: %v", err)
+	}
+
+	analysis.Missing = []string{}
+	components := []struct {
+		name      string
+		component domain.STARComponent
+	}{
+		{domain.STARSituation, analysis.Situation},
+		{domain.STARTask, analysis.Task},
+		{domain.STARAction, analysis.Action},
+		{domain.STARResult, analysis.Result},
+	}
+	for _, c := range components {
+		if !c.component.Covered {
+			analysis.Missing = append(analysis.Missing, c.name)
+		}
+	}
+	return analysis, nil
+}
+
+// FeedbackPromptVersion identifies the grading prompts and scoring recorded on feedback runs.
+// Bump it whenever BuildFeedbackPrompt, BuildSTARPrompt or the scoring formulas change.
+const FeedbackPromptVersion = 1
+
+// BuildFeedbackPrompt builds the grading prompt for a single question and answer.
+// planned is set when the question came from the question bank.
+func BuildFeedbackPrompt(template domain.InterviewTemplate, room domain.Room, question, answer string, planned *domain.PlannedQuestion) string {
+	var rubric strings.Builder
+	for _, criterion := range template.Rubric {
+		rubric.WriteString(fmt.Sprintf("- %s\n", criterion))
+	}
+	if planned != nil {
+		for _, criterion := range planned.Rubric {
+			rubric.WriteString(fmt.Sprintf("- %s\n", criterion))
+		}
+		if planned.ReferenceAnswer != "" {
+			rubric.WriteString(fmt.Sprintf("\nReference answer: %s\n", planned.ReferenceAnswer))
+		}
+	}
+	if room.JobProfile != nil {
+		rubric.WriteString("\nAlso judge how well the answer demonstrates the requirements of the target job:\n")
+		rubric.WriteString(describeJobProfile(*room.JobProfile))
+	}
+
+	var criteria strings.Builder
+	for _, criterion := range TemplateCriteria(template) {
+		criteria.WriteString(fmt.Sprintf("- %s: %s\n", criterion.Key, criterion.Description))
+	}
+
+	return fmt.Sprintf(`You are an AI interviewer providing feedback for a %s. The role is %s and the topic is %s.
+
+Question: %s
+Answer: %s
+
+The answer may include the interviewer's follow-up questions; grade the candidate's replies together as one answer.
+Evaluate the answer against this rubric:
+%s
+Please provide:
+1. List of strengths in the answer
+2. Areas for improvement
+3. Score percentage (0-100)
+4. A score (0-100) for each of these criteria, with short quotes copied word for word from the answer as evidence:
+%s
+Format your response as JSON:
+%s
+and add the criterion scores to it as:
+"criteria": [{"criterion": "criterion key", "score": 70, "evidence": ["exact quote from the answer"], "comment": "why this score"}]`, template.Name, room.Role, room.Topic, question, answer, rubric.String(), criteria.String(), template.FeedbackSchema)
+}
@@ -0,0 +1,106 @@
+package infrastructure
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// questionImportRecord is the JSON shape accepted by the bulk import
+type questionImportRecord struct {
+	Text            string   `json:"text"`
+	Role            string   `json:"role"`
+	Topic           string   `json:"topic"`
+	Difficulty      string   `json:"difficulty"`
+	Tags            []string `json:"tags"`
+	ReferenceAnswer string   `json:"reference_answer"`
+	Rubric          []string `json:"rubric"`
+}
+
+// ParseQuestionsJSON parses a JSON array of questions
+func ParseQuestionsJSON(reader io.Reader) ([]domain.Question, error) {
+	var records []questionImportRecord
+	if err := json.NewDecoder(reader).Decode(&records); err != nil {
+		return nil, fmt.Errorf("failed to parse questions JSON: %v", err)
+	}
+
+	questions := make([]domain.Question, 0, len(records))
+	for _, record := range records {
+		questions = append(questions, domain.Question{
+			Text:            record.Text,
+			Role:            record.Role,
+			Topic:           record.Topic,
+			Difficulty:      record.Difficulty,
+			Tags:            record.Tags,
+			ReferenceAnswer: record.ReferenceAnswer,
+			Rubric:          record.Rubric,
+		})
+	}
+	return questions, nil
+}
+
+// ParseQuestionsCSV parses questions from CSV with a header row.
+// Recognised columns are text, role, topic, difficulty, tags, reference_answer and rubric;
+// tags and rubric hold several values separated by ";".
+func ParseQuestionsCSV(reader io.Reader) ([]domain.Question, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %v", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["text"]; !ok {
+		return nil, fmt.Errorf("CSV header must contain a text column")
+	}
+
+	field := func(row []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	var questions []domain.Question
+	for line := 2; ; line++ {
+		row, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV line %d: %v", line, err)
+		}
+
+		questions = append(questions, domain.Question{
+			Text:            field(row, "text"),
+			Role:            field(row, "role"),
+			Topic:           field(row, "topic"),
+			Difficulty:      field(row, "difficulty"),
+			Tags:            splitList(field(row, "tags")),
+			ReferenceAnswer: field(row, "reference_answer"),
+			Rubric:          splitList(field(row, "rubric")),
+		})
+	}
+	return questions, nil
+}
+
+// splitList splits a ";" separated cell into trimmed, non-empty values
+func splitList(value string) []string {
+	values := []string{}
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
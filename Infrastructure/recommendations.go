@@ -0,0 +1,155 @@
+package infrastructure
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// PracticeProfile is what a user's completed rooms say about what to practice
+type PracticeProfile struct {
+	Topics   []domain.TopicScore // weakest first
+	Criteria []CriterionAverage  // weakest first
+	Roles    map[string]string   // latest role per lower-cased topic
+	Role     string              // latest role overall
+}
+
+// CriterionAverage is the average score of one rubric criterion across completed rooms
+type CriterionAverage struct {
+	Criterion    string
+	AverageScore float64
+}
+
+// BuildPracticeProfile ranks the topics and rubric criteria of the completed rooms from weakest to strongest
+func BuildPracticeProfile(rooms []domain.Room) PracticeProfile {
+	profile := PracticeProfile{Roles: make(map[string]string)}
+
+	topics := ComputeOverallMetrics(rooms).TopicScores
+	for i := len(topics) - 1; i >= 0; i-- {
+		profile.Topics = append(profile.Topics, topics[i])
+	}
+
+	// Rooms are visited oldest first so the latest role wins
+	completed := make([]domain.Room, 0, len(rooms))
+	for _, room := range rooms {
+		if room.Status == "completed" {
+			completed = append(completed, room)
+		}
+	}
+	sort.SliceStable(completed, func(i, j int) bool { return completed[i].CreatedAt < completed[j].CreatedAt })
+
+	sums := make(map[string]int)
+	counts := make(map[string]int)
+	for _, room := range completed {
+		profile.Role = room.Role
+		profile.Roles[strings.ToLower(strings.TrimSpace(room.Topic))] = room.Role
+		for criterion, score := range room.CriterionScores {
+			sums[criterion] += score
+			counts[criterion]++
+		}
+	}
+	for criterion, count := range counts {
+		profile.Criteria = append(profile.Criteria, CriterionAverage{
+			Criterion:    criterion,
+			AverageScore: roundTo(float64(sums[criterion])/float64(count), 1),
+		})
+	}
+	sort.Slice(profile.Criteria, func(i, j int) bool {
+		a, b := profile.Criteria[i], profile.Criteria[j]
+		if a.AverageScore != b.AverageScore {
+			return a.AverageScore < b.AverageScore
+		}
+		return a.Criterion < b.Criterion
+	})
+	return profile
+}
+
+// CriterionInterviewType is the interview type whose built-in rubric weighs a criterion
+// the most, which makes it the best practice for that criterion
+func CriterionInterviewType(criterion string) string {
+	best := domain.InterviewTypeGeneral
+	bestWeight := 0.0
+	for _, template := range DefaultInterviewTemplates() {
+		for _, c := range template.Criteria {
+			if c.Key == criterion && c.Weight > bestWeight {
+				best, bestWeight = template.Type, c.Weight
+			}
+		}
+	}
+	return best
+}
+
+// practiceDifficulty picks the difficulty for a topic average, stepped up by level
+func practiceDifficulty(averageScore float64, level int) string {
+	difficulties := []string{domain.DifficultyEasy, domain.DifficultyMedium, domain.DifficultyHard}
+	index := 0
+	switch {
+	case averageScore >= 75:
+		index = 2
+	case averageScore >= 50:
+		index = 1
+	}
+	return difficulties[int(math.Min(float64(index+level), 2))]
+}
+
+// Recommend proposes count rooms, pairing the weakest topics with the weakest criteria
+// in turn. offset skips the first pairings so consecutive weeks vary, and level raises
+// the difficulty as a plan progresses.
+func Recommend(profile PracticeProfile, count, offset, level int) []domain.Recommendation {
+	if len(profile.Topics) == 0 {
+		return nil
+	}
+
+	recommendations := make([]domain.Recommendation, 0, count)
+	for i := offset; i < offset+count; i++ {
+		topic := profile.Topics[i%len(profile.Topics)]
+		recommendation := domain.Recommendation{
+			Role:          profile.Role,
+			Topic:         topic.Topic,
+			Difficulty:    practiceDifficulty(topic.AverageScore, level),
+			InterviewType: domain.InterviewTypeGeneral,
+			Focus:         []string{},
+			Reason:        fmt.Sprintf("You average %.0f%% on %s", topic.AverageScore, topic.Topic),
+		}
+		if role, ok := profile.Roles[strings.ToLower(topic.Topic)]; ok {
+			recommendation.Role = role
+		}
+
+		if len(profile.Criteria) > 0 {
+			criterion := profile.Criteria[i%len(profile.Criteria)]
+			recommendation.InterviewType = CriterionInterviewType(criterion.Criterion)
+			recommendation.Focus = append(recommendation.Focus, criterion.Criterion)
+			recommendation.Reason += fmt.Sprintf(" and %.0f%% on %s", criterion.AverageScore, criterion.Criterion)
+		}
+		recommendation.Reason += "."
+		recommendations = append(recommendations, recommendation)
+	}
+	return recommendations
+}
+
+// PracticeWeekGoal describes what a week of recommendations works on
+func PracticeWeekGoal(recommendations []domain.Recommendation) string {
+	var topics, criteria []string
+	seen := make(map[string]bool)
+	for _, recommendation := range recommendations {
+		if !seen["topic:"+recommendation.Topic] {
+			seen["topic:"+recommendation.Topic] = true
+			topics = append(topics, recommendation.Topic)
+		}
+		for _, criterion := range recommendation.Focus {
+			if !seen["criterion:"+criterion] {
+				seen["criterion:"+criterion] = true
+				criteria = append(criteria, criterion)
+			}
+		}
+	}
+
+	goal := fmt.Sprintf("Practice %s", strings.Join(topics, ", "))
+	if len(criteria) > 0 {
+		goal += fmt.Sprintf(" with a focus on %s", strings.Join(criteria, ", "))
+	}
+	return goal
+}
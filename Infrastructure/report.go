@@ -0,0 +1,290 @@
+package infrastructure
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// redactedName replaces the candidate's name in redacted reports
+const redactedName = "[name redacted]"
+
+// reportSpeaker names the sender of a message for readers outside the app
+func reportSpeaker(msg domain.Message) string {
+	if msg.Sender == "user" {
+		return "Candidate"
+	}
+	switch msg.Type {
+	case domain.MessageTypeHint:
+		return "Hint"
+	case domain.MessageTypeModelAnswer:
+		return "Model answer"
+	}
+	if msg.Persona != "" {
+		return fmt.Sprintf("Interviewer (%s)", msg.Persona)
+	}
+	return "Interviewer"
+}
+
+// isNameChar reports whether a rune can be part of a name, in any script
+func isNameChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
+
+// nameParts returns the name and each of its parts of three or more letters, longest
+// first, so "jane.doe" also hides "Jane" and "Doe". It is nil when there is nothing to match.
+func nameParts(name string) []string {
+	var parts []string
+	if name = strings.TrimSpace(name); name != "" {
+		parts = append(parts, name)
+	}
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return !isNameChar(r) }) {
+		if utf8.RuneCountInString(part) >= 3 && part != name {
+			parts = append(parts, part)
+		}
+	}
+	sort.SliceStable(parts, func(i, j int) bool { return len(parts[i]) > len(parts[j]) })
+	return parts
+}
+
+// namePartAt returns the part of the name that appears as a whole word at offset i of text
+func namePartAt(parts []string, text string, i int) string {
+	if before, _ := utf8.DecodeLastRuneInString(text[:i]); i > 0 && isNameChar(before) {
+		return ""
+	}
+	for _, part := range parts {
+		end := i + len(part)
+		if end > len(text) || !strings.EqualFold(text[i:end], part) {
+			continue
+		}
+		if after, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) && isNameChar(after) {
+			continue
+		}
+		return part
+	}
+	return ""
+}
+
+// redact hides the parts of the name wherever they appear as whole words in a text
+func redact(parts []string, text string) string {
+	if len(parts) == 0 {
+		return text
+	}
+	var redacted strings.Builder
+	for i := 0; i < len(text); {
+		if part := namePartAt(parts, text, i); part != "" {
+			redacted.WriteString(redactedName)
+			i += len(part)
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		redacted.WriteString(text[i : i+size])
+		i += size
+	}
+	return redacted.String()
+}
+
+// BuildReport renders a room as a report. With redactName the candidate's name is left
+// out and hidden wherever it appears in the transcript and feedback.
+func BuildReport(room domain.Room, candidate string, redactName bool) domain.Report {
+	var parts []string
+	if redactName {
+		parts = nameParts(candidate)
+		candidate = ""
+	}
+	redactAll := func(items []string) []string {
+		redacted := make([]string, len(items))
+		for i, item := range items {
+			redacted[i] = redact(parts, item)
+		}
+		return redacted
+	}
+
+	report := domain.Report{
+		Candidate:       candidate,
+		Role:            room.Role,
+		Topic:           room.Topic,
+		InterviewType:   room.InterviewType,
+		Mode:            room.Mode,
+		Difficulty:      room.Difficulty,
+		Status:          room.Status,
+		CreatedAt:       room.CreatedAt,
+		CompletedAt:     room.CompletedAt,
+		Score:           room.PerformancePercentage,
+		AIScore:         AIRoomScore(room),
+		ScoreSource:     room.ScoreSource,
+		CriterionScores: room.CriterionScores,
+		Transcript:      []domain.ReportMessage{},
+		Answers:         []domain.ReportAnswer{},
+	}
+	if report.ScoreSource == "" {
+		report.ScoreSource = domain.ScoreSourceAI
+	}
+
+	for _, msg := range ActiveMessages(room.Messages) {
+		report.Transcript = append(report.Transcript, domain.ReportMessage{
+			Speaker:   reportSpeaker(msg),
+			Text:      redact(parts, msg.Text),
+			Timestamp: msg.Timestamp,
+		})
+	}
+
+	for _, feedback := range room.Feedback {
+		score, source := AuthoritativeScore(feedback)
+		answer := domain.ReportAnswer{
+			Question:       redact(parts, feedback.Question),
+			Answer:         redact(parts, feedback.Answer),
+			Score:          score,
+			AIScore:        feedback.ScorePercentage,
+			ScoreSource:    source,
+			Strengths:      redactAll(feedback.Strength),
+			ToImprove:      redactAll(feedback.ToImprove),
+			AssistanceUsed: feedback.AssistanceUsed,
+			ScorePenalty:   feedback.ScorePenalty,
+		}
+		if feedback.CoachOverride != nil {
+			answer.CoachReason = redact(parts, feedback.CoachOverride.Reason)
+		}
+		for _, criterionScore := range feedback.CriterionScores {
+			criterionScore.Evidence = redactAll(criterionScore.Evidence)
+			criterionScore.Comment = redact(parts, criterionScore.Comment)
+			answer.CriterionScores = append(answer.CriterionScores, criterionScore)
+		}
+		report.Answers = append(report.Answers, answer)
+	}
+	return report
+}
+
+// reportDate formats a Unix timestamp for reports
+func reportDate(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).UTC().Format("2 Jan 2006 15:04 UTC")
+}
+
+// reportHTML is a self-contained page: styles are inline and nothing is loaded from elsewhere
+var reportHTML = template.Must(template.New("report").Funcs(template.FuncMap{
+	"date": reportDate,
+	"inc":  func(i int) int { return i + 1 },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; color: #1f2328; max-width: 820px; margin: 2rem auto; padding: 0 1rem; line-height: 1.5; }
+h1 { font-size: 1.6rem; margin-bottom: 0.2rem; }
+h2 { font-size: 1.25rem; border-bottom: 1px solid #d0d7de; padding-bottom: 0.3rem; margin-top: 2rem; }
+h3 { font-size: 1.05rem; margin-bottom: 0.3rem; }
+.meta { color: #59636e; }
+.score { font-size: 1.1rem; font-weight: 600; }
+.message { margin: 0.6rem 0; padding: 0.5rem 0.8rem; border-radius: 6px; background: #f6f8fa; white-space: pre-wrap; }
+.message.candidate { background: #ddf4ff; }
+.speaker { font-weight: 600; display: block; white-space: normal; }
+.answer { border: 1px solid #d0d7de; border-radius: 6px; padding: 0.6rem 1rem; margin: 1rem 0; page-break-inside: avoid; }
+.coach { color: #8250df; }
+table { border-collapse: collapse; margin: 0.5rem 0; }
+td, th { border: 1px solid #d0d7de; padding: 0.25rem 0.6rem; text-align: left; vertical-align: top; }
+.report + .report { border-top: 2px solid #d0d7de; margin-top: 3rem; }
+@media print { body { margin: 0; max-width: none; } .message, .answer { break-inside: avoid; } .report + .report { break-before: page; border-top: none; } }
+</style>
+</head>
+<body>
+{{- if not .Reports}}
+<p>No interviews yet.</p>
+{{- end}}
+{{- range .Reports}}
+<div class="report">
+<h1>{{.Role}} interview on {{.Topic}}</h1>
+<p class="meta">{{if .Candidate}}{{.Candidate}} · {{end}}{{.InterviewType}} interview{{if .Difficulty}} · {{.Difficulty}}{{end}}{{with date .CreatedAt}} · {{.}}{{end}}{{with date .CompletedAt}} to {{.}}{{end}}</p>
+{{- if eq .Status "completed"}}
+<p class="score">Score {{.Score}}%{{if eq .ScoreSource "coach"}} <span class="coach">(adjusted by a coach, AI score {{.AIScore}}%)</span>{{end}}</p>
+{{- else}}
+<p class="score">In progress, not graded yet</p>
+{{- end}}
+{{- if .CriterionScores}}
+<table>
+<tr><th>Criterion</th><th>Average</th></tr>
+{{- range $criterion, $score := .CriterionScores}}
+<tr><td>{{$criterion}}</td><td>{{$score}}%</td></tr>
+{{- end}}
+</table>
+{{- end}}
+
+<h2>Feedback</h2>
+{{- if not .Answers}}
+<p>No graded answers.</p>
+{{- end}}
+{{- range $i, $answer := .Answers}}
+<div class="answer">
+<h3>Question {{inc $i}}: {{$answer.Question}}</h3>
+<p><strong>Answer:</strong> {{$answer.Answer}}</p>
+<p class="score">Score {{$answer.Score}}%{{if eq $answer.ScoreSource "coach"}} <span class="coach">(coach score, AI score {{$answer.AIScore}}%)</span>{{end}}</p>
+{{- if $answer.CoachReason}}
+<p class="coach"><strong>Coach:</strong> {{$answer.CoachReason}}</p>
+{{- end}}
+{{- if $answer.Strengths}}
+<p><strong>Strengths</strong></p>
+<ul>{{range $answer.Strengths}}<li>{{.}}</li>{{end}}</ul>
+{{- end}}
+{{- if $answer.ToImprove}}
+<p><strong>To improve</strong></p>
+<ul>{{range $answer.ToImprove}}<li>{{.}}</li>{{end}}</ul>
+{{- end}}
+{{- if $answer.CriterionScores}}
+<table>
+<tr><th>Criterion</th><th>Score</th><th>Comment</th></tr>
+{{- range $answer.CriterionScores}}
+<tr><td>{{.Criterion}}</td><td>{{.Score}}%</td><td>{{.Comment}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if $answer.AssistanceUsed}}
+<p class="meta">Assistance used: {{range $j, $a := $answer.AssistanceUsed}}{{if $j}}, {{end}}{{$a}}{{end}} (−{{$answer.ScorePenalty}} points)</p>
+{{- end}}
+</div>
+{{- end}}
+
+<h2>Transcript</h2>
+{{- range .Transcript}}
+<div class="message{{if eq .Speaker "Candidate"}} candidate{{end}}"><span class="speaker">{{.Speaker}}</span>{{.Text}}</div>
+{{- end}}
+</div>
+{{- end}}
+</body>
+</html>
+`))
+
+// ReportTitle is the title of a report's page or document
+func ReportTitle(report domain.Report) string {
+	return fmt.Sprintf("%s interview on %s", report.Role, report.Topic)
+}
+
+// RenderReportHTML renders a report as a self-contained HTML page
+func RenderReportHTML(report domain.Report) ([]byte, error) {
+	return RenderReportsHTML(ReportTitle(report), []domain.Report{report})
+}
+
+// RenderReportsHTML renders reports one after another on a self-contained HTML page.
+// Printed, every report starts on a new page.
+func RenderReportsHTML(title string, reports []domain.Report) ([]byte, error) {
+	var page bytes.Buffer
+	data := struct {
+		Title   string
+		Reports []domain.Report
+	}{title, reports}
+	if err := reportHTML.Execute(&page, data); err != nil {
+		return nil, fmt.Errorf("failed to render report: %v", err)
+	}
+	return page.Bytes(), nil
+}
@@ -0,0 +1,134 @@
+package infrastructure
+
+import (
+	"math"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// ScoreAnswer checks the grader's per-criterion scores against the rubric and
+// combines them into the answer's score:
+//
+//	answer score = Σ weight(c) × score(c) / Σ weight(c)
+//
+// summed over the rubric criteria the grader scored. Scores are clamped to 0-100
+// and evidence quotes that do not appear in the answer are dropped. ok is false
+// when the grader scored none of the criteria.
+func ScoreAnswer(criteria []domain.RubricCriterion, graded []domain.CriterionScore, answer string) (scores []domain.CriterionScore, score int, ok bool) {
+	byKey := make(map[string]domain.CriterionScore, len(graded))
+	for _, criterionScore := range graded {
+		byKey[strings.ToLower(strings.TrimSpace(criterionScore.Criterion))] = criterionScore
+	}
+
+	var weighted, totalWeight float64
+	for _, criterion := range criteria {
+		criterionScore, found := byKey[strings.ToLower(criterion.Key)]
+		if !found {
+			continue
+		}
+
+		criterionScore.Criterion = criterion.Key
+		criterionScore.Weight = criterion.Weight
+		criterionScore.Score = clampScore(criterionScore.Score)
+		criterionScore.Evidence = quotedEvidence(criterionScore.Evidence, answer)
+		scores = append(scores, criterionScore)
+
+		weighted += criterion.Weight * float64(criterionScore.Score)
+		totalWeight += criterion.Weight
+	}
+
+	if totalWeight == 0 {
+		return nil, 0, false
+	}
+	return scores, int(math.Round(weighted / totalWeight)), true
+}
+
+// ScoreRoom aggregates the feedback of a room into its performance percentage.
+// Each criterion is first averaged over the answers that were scored on it, then
+// the criterion averages are combined with the rubric weights, and finally the
+// average assistance penalty of the answers is subtracted:
+//
+//	criterion average(c) = Σ score(a, c) / answers scored on c
+//	room score = Σ weight(c) × criterion average(c) / Σ weight(c) − Σ penalty(a) / answers
+//
+// A criterion the candidate kept failing therefore weighs on the room score as much
+// as its weight says, however many answers it was scored on. An answer the grader
+// gave no criterion scores counts its score before the penalty on every criterion,
+// and when no answer has criterion scores the room gets the plain mean of the
+// answer scores.
+func ScoreRoom(criteria []domain.RubricCriterion, feedbacks []domain.Feedback) (int64, map[string]int) {
+	if len(feedbacks) == 0 {
+		return 0, nil
+	}
+
+	sums := make(map[string]int)
+	counts := make(map[string]int)
+	penalty := 0
+	total := 0
+	unscored := []domain.Feedback{}
+	for _, feedback := range feedbacks {
+		if len(feedback.CriterionScores) == 0 {
+			unscored = append(unscored, feedback)
+		}
+		for _, criterionScore := range feedback.CriterionScores {
+			sums[criterionScore.Criterion] += criterionScore.Score
+			counts[criterionScore.Criterion]++
+		}
+		penalty += feedback.ScorePenalty
+		total += feedback.ScorePercentage
+	}
+
+	if len(counts) == 0 {
+		return int64(total / len(feedbacks)), nil
+	}
+
+	for _, feedback := range unscored {
+		for _, criterion := range criteria {
+			sums[criterion.Key] += feedback.ScorePercentage + feedback.ScorePenalty
+			counts[criterion.Key]++
+		}
+	}
+
+	averages := make(map[string]int, len(counts))
+	var weighted, totalWeight float64
+	for _, criterion := range criteria {
+		count := counts[criterion.Key]
+		if count == 0 {
+			continue
+		}
+		average := float64(sums[criterion.Key]) / float64(count)
+		averages[criterion.Key] = int(math.Round(average))
+		weighted += criterion.Weight * average
+		totalWeight += criterion.Weight
+	}
+	if totalWeight == 0 {
+		return int64(total / len(feedbacks)), nil
+	}
+
+	score := int(math.Round(weighted/totalWeight - float64(penalty)/float64(len(feedbacks))))
+	return int64(clampScore(score)), averages
+}
+
+func clampScore(score int) int {
+	if score < 0 {
+		return 0
+	}
+	if score > 100 {
+		return 100
+	}
+	return score
+}
+
+// quotedEvidence keeps the quotes that really appear in the answer
+func quotedEvidence(evidence []string, answer string) []string {
+	normalizedAnswer := strings.ToLower(strings.Join(strings.Fields(answer), " "))
+	quotes := []string{}
+	for _, quote := range evidence {
+		normalizedQuote := strings.ToLower(strings.Join(strings.Fields(strings.Trim(quote, `"'“”`)), " "))
+		if normalizedQuote != "" && strings.Contains(normalizedAnswer, normalizedQuote) {
+			quotes = append(quotes, quote)
+		}
+	}
+	return quotes
+}
@@ -0,0 +1,205 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// defaultSkills is the built-in skill taxonomy. A stored skill with the same key
+// replaces the built-in one.
+var defaultSkills = []domain.Skill{
+	{Key: "backend", Name: "Backend development", Aliases: []string{"backend", "back-end", "server-side", "backend engineer", "backend developer"}},
+	{Key: "go", Name: "Go", ParentKey: "backend", Aliases: []string{"go", "golang", "go language", "go programming", "go developer"}},
+	{Key: "go_concurrency", Name: "Go concurrency", ParentKey: "go", Aliases: []string{"goroutine", "goroutines", "go channels", "go concurrency", "golang concurrency", "sync.mutex", "waitgroup"}},
+	{Key: "python", Name: "Python", ParentKey: "backend", Aliases: []string{"python", "django", "flask", "fastapi"}},
+	{Key: "java", Name: "Java", ParentKey: "backend", Aliases: []string{"java", "spring boot", "jvm"}},
+	{Key: "nodejs", Name: "Node.js", ParentKey: "backend", Aliases: []string{"node.js", "nodejs", "express.js", "expressjs"}},
+	{Key: "databases", Name: "Databases", ParentKey: "backend", Aliases: []string{"database", "databases", "sql", "nosql", "mongodb", "postgres", "postgresql", "mysql", "query optimization", "indexing", "transactions"}},
+	{Key: "api_design", Name: "API design", ParentKey: "backend", Aliases: []string{"api", "apis", "rest api", "rest apis", "restful", "graphql", "grpc", "api design"}},
+
+	{Key: "frontend", Name: "Frontend development", Aliases: []string{"frontend", "front-end", "frontend engineer", "frontend developer", "web ui"}},
+	{Key: "javascript", Name: "JavaScript", ParentKey: "frontend", Aliases: []string{"javascript", "js", "typescript", "ecmascript"}},
+	{Key: "react", Name: "React", ParentKey: "frontend", Aliases: []string{"reactjs", "react.js", "react hooks", "react components", "react developer", "next.js", "nextjs"}},
+	{Key: "css", Name: "HTML and CSS", ParentKey: "frontend", Aliases: []string{"css", "html", "flexbox", "responsive design", "tailwind"}},
+
+	{Key: "mobile", Name: "Mobile development", Aliases: []string{"mobile", "android", "ios", "flutter", "react native", "swiftui", "swift developer", "kotlin"}},
+
+	{Key: "system_design", Name: "System design", Aliases: []string{"system design", "architecture", "software architecture", "design a system"}},
+	{Key: "scalability", Name: "Scalability", ParentKey: "system_design", Aliases: []string{"scalability", "scaling", "load balancing", "load balancer", "horizontal scaling", "sharding"}},
+	{Key: "caching", Name: "Caching", ParentKey: "system_design", Aliases: []string{"cache", "caching", "redis", "memcached", "cdn"}},
+	{Key: "distributed_systems", Name: "Distributed systems", ParentKey: "system_design", Aliases: []string{"distributed systems", "distributed system", "replication", "consensus", "cap theorem", "eventual consistency", "message queue", "kafka", "microservices"}},
+
+	{Key: "algorithms", Name: "Algorithms and data structures", Aliases: []string{"algorithms", "algorithm", "data structures", "dsa", "leetcode", "coding interview"}},
+	{Key: "data_structures", Name: "Data structures", ParentKey: "algorithms", Aliases: []string{"data structure", "data structures", "linked list", "hash map", "hash table", "binary tree", "binary heap", "priority queue", "stack data structure", "queue data structure", "dynamic array", "trie", "graph traversal", "graph algorithms"}},
+	{Key: "complexity", Name: "Complexity analysis", ParentKey: "algorithms", Aliases: []string{"big o", "time complexity", "space complexity", "complexity analysis"}},
+	{Key: "dynamic_programming", Name: "Dynamic programming", ParentKey: "algorithms", Aliases: []string{"dynamic programming", "memoization", "dp"}},
+
+	{Key: "devops", Name: "DevOps and cloud", Aliases: []string{"devops", "sre", "site reliability", "infrastructure"}},
+	{Key: "containers", Name: "Containers", ParentKey: "devops", Aliases: []string{"docker", "kubernetes", "k8s", "container", "containers"}},
+	{Key: "ci_cd", Name: "CI/CD", ParentKey: "devops", Aliases: []string{"ci/cd", "continuous integration", "continuous delivery", "continuous deployment", "github actions", "jenkins"}},
+	{Key: "cloud", Name: "Cloud platforms", ParentKey: "devops", Aliases: []string{"aws", "gcp", "azure", "cloud", "serverless", "lambda"}},
+
+	{Key: "data", Name: "Data and machine learning", Aliases: []string{"data science", "data scientist", "data engineer", "data engineering", "analytics"}},
+	{Key: "machine_learning", Name: "Machine learning", ParentKey: "data", Aliases: []string{"machine learning", "ml", "deep learning", "neural network", "neural networks", "llm", "nlp"}},
+
+	{Key: "behavioral", Name: "Behavioral skills", Aliases: []string{"behavioral", "behavioural", "soft skills", "tell me about a time"}},
+	{Key: "leadership", Name: "Leadership", ParentKey: "behavioral", Aliases: []string{"leadership", "mentoring", "mentorship", "ownership", "led a team"}},
+	{Key: "teamwork", Name: "Teamwork", ParentKey: "behavioral", Aliases: []string{"teamwork", "collaboration", "cross-functional", "stakeholder", "stakeholders"}},
+	{Key: "conflict_resolution", Name: "Conflict resolution", ParentKey: "behavioral", Aliases: []string{"conflict", "disagreement", "difficult coworker", "difficult colleague"}},
+	{Key: "communication", Name: "Communication", ParentKey: "behavioral", Aliases: []string{"communication", "presentation", "explain to a non-technical"}},
+
+	{Key: "product", Name: "Product management", Aliases: []string{"product manager", "product management", "product sense", "roadmap", "prioritization", "prioritisation"}},
+}
+
+// DefaultSkill returns the built-in skill with the given key
+func DefaultSkill(key string) (domain.Skill, bool) {
+	for _, skill := range defaultSkills {
+		if skill.Key == key {
+			return skill, true
+		}
+	}
+	return domain.Skill{}, false
+}
+
+// DefaultSkills returns the built-in skill taxonomy
+func DefaultSkills() []domain.Skill {
+	skills := make([]domain.Skill, len(defaultSkills))
+	copy(skills, defaultSkills)
+	return skills
+}
+
+// containsTerm reports whether text mentions term as a whole word or phrase
+func containsTerm(text, term string) bool {
+	isWordChar := func(ch byte) bool {
+		return (ch >= 'a' && ch <= 'z') || (ch >= '0' && ch <= '9')
+	}
+	for offset := 0; offset < len(text); {
+		index := strings.Index(text[offset:], term)
+		if index == -1 {
+			return false
+		}
+		start := offset + index
+		end := start + len(term)
+		if (start == 0 || !isWordChar(text[start-1])) && (end == len(text) || !isWordChar(text[end])) {
+			return true
+		}
+		offset = start + 1
+	}
+	return false
+}
+
+// MatchSkills tags text with the skills whose aliases it mentions; a skill without
+// aliases matches on its name. Aliases are kept to terms that are not ordinary words
+// ("react.js" rather than "react"), and those of up to two characters, such as "go",
+// only match when they are the whole text. When a skill and one of its ancestors both
+// match only the more specific skill is kept.
+func MatchSkills(skills []domain.Skill, text string) []string {
+	text = strings.ToLower(strings.TrimSpace(text))
+	if text == "" {
+		return nil
+	}
+
+	matched := make(map[string]bool)
+	for _, skill := range skills {
+		terms := skill.Aliases
+		if len(terms) == 0 {
+			terms = []string{skill.Name}
+		}
+		for _, term := range terms {
+			term = strings.ToLower(strings.TrimSpace(term))
+			if term == "" {
+				continue
+			}
+			if term == text || (len(term) > 2 && containsTerm(text, term)) {
+				matched[skill.Key] = true
+				break
+			}
+		}
+	}
+	return mostSpecificSkills(skills, matched)
+}
+
+// mostSpecificSkills drops the matched skills that are ancestors of other matched skills
+func mostSpecificSkills(skills []domain.Skill, matched map[string]bool) []string {
+	parents := SkillParents(skills)
+	for key := range matched {
+		for parent := parents[key]; parent != ""; parent = parents[parent] {
+			delete(matched, parent)
+		}
+	}
+
+	keys := make([]string, 0, len(matched))
+	for key := range matched {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// SkillParents maps every skill key to its parent key
+func SkillParents(skills []domain.Skill) map[string]string {
+	parents := make(map[string]string, len(skills))
+	for _, skill := range skills {
+		parents[skill.Key] = skill.ParentKey
+	}
+	return parents
+}
+
+// SkillAncestors returns a skill key followed by the keys of its ancestors
+func SkillAncestors(parents map[string]string, key string) []string {
+	keys := []string{key}
+	seen := map[string]bool{key: true}
+	for parent := parents[key]; parent != "" && !seen[parent]; parent = parents[parent] {
+		keys = append(keys, parent)
+		seen[parent] = true
+	}
+	return keys
+}
+
+// BuildSkillTaggingPrompt asks Gemini which skills of the taxonomy a text is about
+func BuildSkillTaggingPrompt(skills []domain.Skill, text string) string {
+	var taxonomy strings.Builder
+	for _, skill := range skills {
+		line := fmt.Sprintf("- %s: %s", skill.Key, skill.Name)
+		if skill.ParentKey != "" {
+			line += fmt.Sprintf(" (part of %s)", skill.ParentKey)
+		}
+		taxonomy.WriteString(line + "\n")
+	}
+
+	return fmt.Sprintf(`You tag interview content with skills from a fixed taxonomy.
+
+Taxonomy (key: name):
+%s
+Content: %s
+
+Pick the most specific skills the content is about, at most three. Only use keys from the taxonomy.
+Respond ONLY with a JSON object in this format:
+{
+    "skills": ["key1", "key2"]
+}`, taxonomy.String(), text)
+}
+
+// ParseSkillTags reads Gemini's tags, keeping only keys of the taxonomy
+func ParseSkillTags(skills []domain.Skill, response string) ([]string, error) {
+	var tags struct {
+		Skills []string `json:"skills"`
+	}
+	if err := json.Unmarshal([]byte(ExtractJSON(response)), &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse skill tags: %v", err)
+	}
+
+	known := SkillParents(skills)
+	matched := make(map[string]bool)
+	for _, key := range tags.Skills {
+		key = strings.TrimSpace(key)
+		if _, ok := known[key]; ok {
+			matched[key] = true
+		}
+	}
+	return mostSpecificSkills(skills, matched), nil
+}
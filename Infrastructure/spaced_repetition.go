@@ -0,0 +1,84 @@
+package infrastructure
+
+import (
+	"math"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// Spaced repetition settings
+const (
+	ReviewScoreThreshold = 70  // answers scoring below this are scheduled for review
+	initialEaseFactor    = 2.5 // SM-2 starting ease
+	minimumEaseFactor    = 1.3
+)
+
+// NewReviewItem schedules a poorly answered question; it is due straight away
+func NewReviewItem(room domain.Room, feedback domain.Feedback, now time.Time) domain.ReviewItem {
+	score, _ := AuthoritativeScore(feedback)
+	item := domain.ReviewItem{
+		UserID:     room.UserID,
+		RoomID:     room.ID,
+		MessageID:  feedback.MessageID,
+		Role:       room.Role,
+		Topic:      room.Topic,
+		Question:   feedback.Question,
+		ToImprove:  feedback.ToImprove,
+		EaseFactor: initialEaseFactor,
+		LastScore:  score,
+		DueAt:      now.Unix(),
+		CreatedAt:  now.Unix(),
+	}
+	if feedback.STAR != nil {
+		item.ExemplarAnswer = feedback.STAR.ExemplarAnswer
+	}
+	return item
+}
+
+// reviewQuality maps a score percentage onto the SM-2 grade from 0 to 5; scores
+// below 60 fail the review
+func reviewQuality(score int) int {
+	return clampScore(score) / 20
+}
+
+// ScheduleReview applies the SM-2 algorithm to an item that was answered again with
+// the given score. A grade below 3 starts the repetitions over.
+func ScheduleReview(item *domain.ReviewItem, score int, now time.Time) {
+	quality := reviewQuality(score)
+
+	if quality < 3 {
+		item.Repetitions = 0
+		item.Interval = 1
+	} else {
+		switch item.Repetitions {
+		case 0:
+			item.Interval = 1
+		case 1:
+			item.Interval = 6
+		default:
+			item.Interval = int(math.Round(float64(item.Interval) * item.EaseFactor))
+		}
+		item.Repetitions++
+	}
+
+	if item.EaseFactor == 0 {
+		item.EaseFactor = initialEaseFactor
+	}
+	missed := float64(5 - quality)
+	item.EaseFactor = math.Max(minimumEaseFactor, item.EaseFactor+0.1-missed*(0.08+missed*0.02))
+
+	item.LastScore = score
+	item.ReviewedAt = now.Unix()
+	item.DueAt = now.AddDate(0, 0, item.Interval).Unix()
+}
+
+// ReviewPlannedQuestion turns a review item into a question for a review room
+func ReviewPlannedQuestion(item domain.ReviewItem) domain.PlannedQuestion {
+	return domain.PlannedQuestion{
+		QuestionID:      item.ID,
+		Text:            item.Question,
+		ReferenceAnswer: item.ExemplarAnswer,
+		Rubric:          item.ToImprove,
+	}
+}
@@ -0,0 +1,54 @@
+package speech
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// localWordsPerSecond paces the synthetic word timestamps (150 words per minute)
+const localWordsPerSecond = 2.5
+
+// LocalSpeechToText is a stand-in for a real speech-to-text service, used in
+// development and tests. It accepts "audio" that is really UTF-8 text and returns
+// it as the transcript with evenly paced word timestamps. Gaps can be simulated
+// with a "..." token, which adds a two second pause.
+type LocalSpeechToText struct{}
+
+func NewLocalSpeechToText() domain.SpeechToText {
+	return &LocalSpeechToText{}
+}
+
+// Transcribe implements domain.SpeechToText.
+func (s *LocalSpeechToText) Transcribe(c context.Context, audio io.Reader, contentType string) (domain.Transcript, error) {
+	content, err := io.ReadAll(audio)
+	if err != nil {
+		return domain.Transcript{}, fmt.Errorf("failed to read audio: %v", err)
+	}
+	if !utf8.Valid(content) || !strings.HasPrefix(contentType, "text/") {
+		return domain.Transcript{}, fmt.Errorf("the local speech-to-text stand-in only accepts text/plain recordings")
+	}
+
+	transcript := domain.Transcript{Words: []domain.TranscriptWord{}}
+	var words []string
+	position := 0.0
+	for _, token := range strings.Fields(string(content)) {
+		if token == "..." {
+			position += 2
+			continue
+		}
+
+		end := position + 1/localWordsPerSecond
+		transcript.Words = append(transcript.Words, domain.TranscriptWord{Word: token, Start: position, End: end})
+		words = append(words, token)
+		position = end
+	}
+
+	transcript.Text = strings.Join(words, " ")
+	transcript.Duration = position
+	return transcript, nil
+}
@@ -0,0 +1,72 @@
+package speech
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+const (
+	localSampleRate    = 16000
+	localWordSeconds   = 0.3
+	localGapSeconds    = 0.1
+	localToneAmplitude = 3000
+)
+
+// LocalTextToSpeech is a stand-in for a real text-to-speech service, used in
+// development and tests. It renders one short tone per word as a 16-bit mono WAV,
+// so clients get correctly paced, playable audio without an external service.
+// Each voice gets its own pitch.
+type LocalTextToSpeech struct{}
+
+func NewLocalTextToSpeech() domain.TextToSpeech {
+	return &LocalTextToSpeech{}
+}
+
+// Synthesize implements domain.TextToSpeech.
+func (s *LocalTextToSpeech) Synthesize(c context.Context, text string, voice string) ([]byte, string, error) {
+	frequency := 440.0
+	for _, r := range voice {
+		frequency += float64(r % 40)
+	}
+
+	wordSamples := int(localWordSeconds * localSampleRate)
+	gapSamples := int(localGapSeconds * localSampleRate)
+
+	var samples []int16
+	for range strings.Fields(text) {
+		for i := 0; i < wordSamples; i++ {
+			value := localToneAmplitude * math.Sin(2*math.Pi*frequency*float64(i)/localSampleRate)
+			samples = append(samples, int16(value))
+		}
+		samples = append(samples, make([]int16, gapSamples)...)
+	}
+
+	return encodeWAV(samples), "audio/wav", nil
+}
+
+// encodeWAV wraps 16-bit mono PCM samples in a RIFF/WAVE container
+func encodeWAV(samples []int16) []byte {
+	dataSize := uint32(len(samples) * 2)
+
+	var buffer bytes.Buffer
+	buffer.WriteString("RIFF")
+	binary.Write(&buffer, binary.LittleEndian, uint32(36)+dataSize)
+	buffer.WriteString("WAVE")
+	buffer.WriteString("fmt ")
+	binary.Write(&buffer, binary.LittleEndian, uint32(16))                // fmt chunk size
+	binary.Write(&buffer, binary.LittleEndian, uint16(1))                 // PCM
+	binary.Write(&buffer, binary.LittleEndian, uint16(1))                 // mono
+	binary.Write(&buffer, binary.LittleEndian, uint32(localSampleRate))   // sample rate
+	binary.Write(&buffer, binary.LittleEndian, uint32(localSampleRate*2)) // byte rate
+	binary.Write(&buffer, binary.LittleEndian, uint16(2))                 // block align
+	binary.Write(&buffer, binary.LittleEndian, uint16(16))                // bits per sample
+	buffer.WriteString("data")
+	binary.Write(&buffer, binary.LittleEndian, dataSize)
+	binary.Write(&buffer, binary.LittleEndian, samples)
+	return buffer.Bytes()
+}
@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+// LocalBlobStore keeps blobs on the local filesystem under a root directory.
+// The content type is stored next to each blob in a ".type" sidecar file.
+type LocalBlobStore struct {
+	root    string
+	baseURL string
+}
+
+func NewLocalBlobStore(root string, baseURL string) domain.BlobStore {
+	return &LocalBlobStore{
+		root:    root,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+// Key implements domain.BlobStore.
+// Keys are slash separated; empty, "." and ".." segments and backslashes are rejected
+// rather than cleaned, so a key always names the file its segments say.
+func (s *LocalBlobStore) Key(key string) (string, error) {
+	key = strings.TrimPrefix(key, "/")
+	if key == "" || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", fmt.Errorf("invalid blob key %q", key)
+		}
+	}
+	return path.Clean(key), nil
+}
+
+// path maps a key to a file inside the root
+func (s *LocalBlobStore) path(key string) (string, error) {
+	key, err := s.Key(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// Put implements domain.BlobStore.
+func (s *LocalBlobStore) Put(c context.Context, key string, contentType string, content io.Reader) (string, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create blob directory: %v", err)
+	}
+
+	// Write to a temporary file first so readers never see a partial blob
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create blob: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write blob: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write blob: %v", err)
+	}
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return "", fmt.Errorf("failed to store blob: %v", err)
+	}
+
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(filePath))
+	}
+	if err := os.WriteFile(filePath+".type", []byte(contentType), 0o644); err != nil {
+		return "", fmt.Errorf("failed to store blob content type: %v", err)
+	}
+
+	return s.URL(key), nil
+}
+
+// Get implements domain.BlobStore.
+func (s *LocalBlobStore) Get(c context.Context, key string) (io.ReadCloser, string, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, "", fmt.Errorf("blob not found")
+		}
+		return nil, "", err
+	}
+
+	contentType := "application/octet-stream"
+	if stored, err := os.ReadFile(filePath + ".type"); err == nil && len(stored) > 0 {
+		contentType = string(stored)
+	}
+	return file, contentType, nil
+}
+
+// Exists implements domain.BlobStore.
+func (s *LocalBlobStore) Exists(c context.Context, key string) (bool, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(filePath)
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// Delete implements domain.BlobStore.
+func (s *LocalBlobStore) Delete(c context.Context, key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	_ = os.Remove(filePath + ".type")
+	return nil
+}
+
+// URL implements domain.BlobStore.
+func (s *LocalBlobStore) URL(key string) string {
+	return s.baseURL + path.Clean("/"+key)
+}
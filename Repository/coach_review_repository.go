@@ -0,0 +1,370 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Roles of the authors of annotations
+const (
+	annotationRoleCoach     = "coach"
+	annotationRoleCandidate = "candidate"
+)
+
+type coachReviewRepository struct {
+	database               mongo.Database
+	collection             string
+	organizationRepository domain.OrganizationRepository
+}
+
+func NewCoachReviewRepository(database mongo.Database, collection string, organizationRepository domain.OrganizationRepository) domain.CoachReviewRepository {
+	return &coachReviewRepository{
+		database:               database,
+		collection:             collection,
+		organizationRepository: organizationRepository,
+	}
+}
+
+// reviewer is the acting user of a room: its candidate or one of their coaches
+type reviewer struct {
+	UserID primitive.ObjectID
+	Name   string
+	Role   string
+}
+
+// reviewedRoom loads a room that the user owns or coaches. Users who cannot see the
+// room get "room not found" so they cannot tell which rooms exist.
+func (r *coachReviewRepository) reviewedRoom(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, reviewer, error) {
+	objectID, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return domain.Room{}, reviewer{}, fmt.Errorf("invalid room ID format: %v", err)
+	}
+
+	var room domain.Room
+	err = r.database.Collection(domain.CollectionRoom).FindOne(c, bson.M{"_id": objectID}).Decode(&room)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.Room{}, reviewer{}, fmt.Errorf("room not found")
+		}
+		return domain.Room{}, reviewer{}, err
+	}
+
+	if room.UserID == userID {
+		var user domain.User
+		err := r.database.Collection(domain.CollectionUser).FindOne(c, bson.M{"_id": userID}).Decode(&user)
+		if err != nil {
+			return domain.Room{}, reviewer{}, fmt.Errorf("failed to load user: %v", err)
+		}
+		return room, reviewer{UserID: userID, Name: user.Username, Role: annotationRoleCandidate}, nil
+	}
+
+	coach, err := r.organizationRepository.CoachMembership(c, userID, room.UserID)
+	if err != nil {
+		if err.Error() == "you do not coach this candidate" {
+			return domain.Room{}, reviewer{}, fmt.Errorf("room not found")
+		}
+		return domain.Room{}, reviewer{}, err
+	}
+	return room, reviewer{UserID: userID, Name: coach.Username, Role: annotationRoleCoach}, nil
+}
+
+// coachedRoom loads a room the user coaches
+func (r *coachReviewRepository) coachedRoom(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, reviewer, error) {
+	room, acting, err := r.reviewedRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Room{}, reviewer{}, err
+	}
+	if acting.Role != annotationRoleCoach {
+		return domain.Room{}, reviewer{}, fmt.Errorf("only coaches can do this")
+	}
+	return room, acting, nil
+}
+
+// GetAnnotations implements domain.CoachReviewRepository.
+func (r *coachReviewRepository) GetAnnotations(c context.Context, userID primitive.ObjectID, roomID string) ([]domain.AnnotationThread, error) {
+	room, _, err := r.reviewedRoom(c, userID, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := r.database.Collection(r.collection).Find(c, bson.M{"room_id": room.ID})
+	if err != nil {
+		return nil, err
+	}
+	var annotations []domain.Annotation
+	if err := cursor.All(c, &annotations); err != nil {
+		return nil, err
+	}
+	return infrastructure.ThreadAnnotations(annotations), nil
+}
+
+// annotation loads an annotation of the room
+func (r *coachReviewRepository) annotation(c context.Context, roomID primitive.ObjectID, annotationID string) (domain.Annotation, error) {
+	objectID, err := primitive.ObjectIDFromHex(annotationID)
+	if err != nil {
+		return domain.Annotation{}, fmt.Errorf("invalid annotation ID format: %v", err)
+	}
+
+	var annotation domain.Annotation
+	err = r.database.Collection(r.collection).FindOne(c, bson.M{"_id": objectID, "room_id": roomID}).Decode(&annotation)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.Annotation{}, fmt.Errorf("annotation not found")
+		}
+		return domain.Annotation{}, err
+	}
+	return annotation, nil
+}
+
+// CreateAnnotation implements domain.CoachReviewRepository.
+// Coaches start threads on a message of the room; coaches and the candidate reply to them.
+func (r *coachReviewRepository) CreateAnnotation(c context.Context, userID primitive.ObjectID, roomID string, request domain.AnnotationRequest) (domain.Annotation, error) {
+	text := strings.TrimSpace(request.Text)
+	if text == "" {
+		return domain.Annotation{}, fmt.Errorf("annotation text is required")
+	}
+
+	room, acting, err := r.reviewedRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Annotation{}, err
+	}
+
+	now := time.Now().Unix()
+	annotation := domain.Annotation{
+		ID:         primitive.NewObjectID(),
+		RoomID:     room.ID,
+		AuthorID:   acting.UserID,
+		AuthorName: acting.Name,
+		AuthorRole: acting.Role,
+		Text:       text,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if request.ParentID != "" {
+		parent, err := r.annotation(c, room.ID, request.ParentID)
+		if err != nil {
+			return domain.Annotation{}, err
+		}
+		if !parent.ParentID.IsZero() {
+			return domain.Annotation{}, fmt.Errorf("replies go to the thread's first annotation")
+		}
+		annotation.ParentID = parent.ID
+		annotation.MessageID = parent.MessageID
+	} else {
+		if acting.Role != annotationRoleCoach {
+			return domain.Annotation{}, fmt.Errorf("only coaches can do this")
+		}
+		messageID, err := primitive.ObjectIDFromHex(request.MessageID)
+		if err != nil {
+			return domain.Annotation{}, fmt.Errorf("invalid message ID format: %v", err)
+		}
+		found := false
+		for _, message := range room.Messages {
+			if message.ID == messageID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return domain.Annotation{}, fmt.Errorf("message not found")
+		}
+		annotation.MessageID = messageID
+	}
+
+	_, err = r.database.Collection(r.collection).InsertOne(c, annotation)
+	if err != nil {
+		return domain.Annotation{}, fmt.Errorf("failed to create annotation: %v", err)
+	}
+	return annotation, nil
+}
+
+// authoredAnnotation loads an annotation the user wrote
+func (r *coachReviewRepository) authoredAnnotation(c context.Context, userID primitive.ObjectID, roomID string, annotationID string) (domain.Annotation, error) {
+	room, _, err := r.reviewedRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Annotation{}, err
+	}
+	annotation, err := r.annotation(c, room.ID, annotationID)
+	if err != nil {
+		return domain.Annotation{}, err
+	}
+	if annotation.AuthorID != userID {
+		return domain.Annotation{}, fmt.Errorf("you can only change your own annotations")
+	}
+	return annotation, nil
+}
+
+// UpdateAnnotation implements domain.CoachReviewRepository.
+func (r *coachReviewRepository) UpdateAnnotation(c context.Context, userID primitive.ObjectID, roomID string, annotationID string, text string) (domain.Annotation, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return domain.Annotation{}, fmt.Errorf("annotation text is required")
+	}
+
+	annotation, err := r.authoredAnnotation(c, userID, roomID, annotationID)
+	if err != nil {
+		return domain.Annotation{}, err
+	}
+	annotation.Text = text
+	annotation.UpdatedAt = time.Now().Unix()
+
+	_, err = r.database.Collection(r.collection).UpdateOne(c, bson.M{"_id": annotation.ID}, bson.M{"$set": bson.M{"text": annotation.Text, "updated_at": annotation.UpdatedAt}})
+	if err != nil {
+		return domain.Annotation{}, err
+	}
+	return annotation, nil
+}
+
+// DeleteAnnotation implements domain.CoachReviewRepository.
+// Deleting the first annotation of a thread deletes its replies too.
+func (r *coachReviewRepository) DeleteAnnotation(c context.Context, userID primitive.ObjectID, roomID string, annotationID string) error {
+	annotation, err := r.authoredAnnotation(c, userID, roomID, annotationID)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.database.Collection(r.collection).DeleteMany(c, bson.M{"$or": []bson.M{
+		{"_id": annotation.ID},
+		{"parent_id": annotation.ID},
+	}})
+	return err
+}
+
+// feedbackOf finds a feedback of the room's canonical run
+func feedbackOf(room domain.Room, feedbackID string) (domain.Feedback, error) {
+	objectID, err := primitive.ObjectIDFromHex(feedbackID)
+	if err != nil {
+		return domain.Feedback{}, fmt.Errorf("invalid feedback ID format: %v", err)
+	}
+	for _, feedback := range room.Feedback {
+		if feedback.ID == objectID {
+			return feedback, nil
+		}
+	}
+	return domain.Feedback{}, fmt.Errorf("feedback not found")
+}
+
+// answerFilter matches the overrides of a feedback's answer
+func answerFilter(room domain.Room, feedback domain.Feedback) bson.M {
+	if !feedback.MessageID.IsZero() {
+		return bson.M{"room_id": room.ID, "message_id": feedback.MessageID}
+	}
+	return bson.M{"room_id": room.ID, "feedback_id": feedback.ID}
+}
+
+// applyOverrides recomputes the room's authoritative scores from its overrides and saves them
+func (r *coachReviewRepository) applyOverrides(c context.Context, room domain.Room) (domain.Room, error) {
+	cursor, err := r.database.Collection(domain.CollectionScoreOverride).Find(c, bson.M{"room_id": room.ID})
+	if err != nil {
+		return domain.Room{}, err
+	}
+	var overrides []domain.ScoreOverride
+	if err := cursor.All(c, &overrides); err != nil {
+		return domain.Room{}, err
+	}
+	infrastructure.ApplyScoreOverrides(&room, overrides)
+
+	_, err = r.database.Collection(domain.CollectionRoom).UpdateOne(c, bson.M{"_id": room.ID}, bson.M{"$set": bson.M{
+		"feedback":                  room.Feedback,
+		"performance_percentage":    room.PerformancePercentage,
+		"ai_performance_percentage": room.AIPerformancePercentage,
+		"score_source":              room.ScoreSource,
+	}})
+	if err != nil {
+		return domain.Room{}, err
+	}
+	return room, nil
+}
+
+// OverrideScore implements domain.CoachReviewRepository.
+// The override is kept next to the AI score; a later override of the same answer replaces it.
+func (r *coachReviewRepository) OverrideScore(c context.Context, userID primitive.ObjectID, roomID string, feedbackID string, request domain.ScoreOverrideRequest) (domain.Room, error) {
+	if request.Score == nil || *request.Score < 0 || *request.Score > 100 {
+		return domain.Room{}, fmt.Errorf("score must be between 0 and 100")
+	}
+	reason := strings.TrimSpace(request.Reason)
+	if reason == "" {
+		return domain.Room{}, fmt.Errorf("a reason is required to override a score")
+	}
+
+	room, acting, err := r.coachedRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	if room.Status != "completed" {
+		return domain.Room{}, fmt.Errorf("room is not completed yet")
+	}
+	feedback, err := feedbackOf(room, feedbackID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+
+	override := domain.ScoreOverride{
+		ID:         primitive.NewObjectID(),
+		RoomID:     room.ID,
+		MessageID:  feedback.MessageID,
+		FeedbackID: feedback.ID,
+		CoachID:    acting.UserID,
+		CoachName:  acting.Name,
+		Score:      *request.Score,
+		AIScore:    feedback.ScorePercentage,
+		Reason:     reason,
+		CreatedAt:  time.Now().Unix(),
+	}
+	_, err = r.database.Collection(domain.CollectionScoreOverride).InsertOne(c, override)
+	if err != nil {
+		return domain.Room{}, fmt.Errorf("failed to save score override: %v", err)
+	}
+	return r.applyOverrides(c, room)
+}
+
+// RemoveScoreOverride implements domain.CoachReviewRepository.
+// Every override of the answer is removed, so the AI score is authoritative again.
+func (r *coachReviewRepository) RemoveScoreOverride(c context.Context, userID primitive.ObjectID, roomID string, feedbackID string) (domain.Room, error) {
+	room, _, err := r.coachedRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	feedback, err := feedbackOf(room, feedbackID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	if feedback.CoachOverride == nil {
+		return domain.Room{}, fmt.Errorf("score override not found")
+	}
+
+	_, err = r.database.Collection(domain.CollectionScoreOverride).DeleteMany(c, answerFilter(room, feedback))
+	if err != nil {
+		return domain.Room{}, err
+	}
+	return r.applyOverrides(c, room)
+}
+
+// GetScoreOverrides implements domain.CoachReviewRepository.
+// It returns every override of the room, oldest first, including replaced ones.
+func (r *coachReviewRepository) GetScoreOverrides(c context.Context, userID primitive.ObjectID, roomID string) ([]domain.ScoreOverride, error) {
+	room, _, err := r.reviewedRoom(c, userID, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := r.database.Collection(domain.CollectionScoreOverride).Find(c, bson.M{"room_id": room.ID}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	overrides := []domain.ScoreOverride{}
+	if err := cursor.All(c, &overrides); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type exportRepository struct {
+	database       mongo.Database
+	roomRepository domain.RoomRepository
+}
+
+func NewExportRepository(database mongo.Database, roomRepository domain.RoomRepository) domain.ExportRepository {
+	return &exportRepository{
+		database:       database,
+		roomRepository: roomRepository,
+	}
+}
+
+// username returns the name exports show for the user
+func (e *exportRepository) username(c context.Context, userID primitive.ObjectID) (string, error) {
+	var user domain.User
+	err := e.database.Collection(domain.CollectionUser).FindOne(c, bson.M{"_id": userID}).Decode(&user)
+	if err != nil {
+		return "", fmt.Errorf("failed to load user: %v", err)
+	}
+	return user.Username, nil
+}
+
+// ExportRoom implements domain.ExportRepository.
+func (e *exportRepository) ExportRoom(c context.Context, userID primitive.ObjectID, roomID string, format string) (domain.ExportFile, error) {
+	if err := infrastructure.ValidateExportFormat(format); err != nil {
+		return domain.ExportFile{}, err
+	}
+
+	room, err := e.roomRepository.GetUserRoom(c, userID, roomID)
+	if err != nil {
+		return domain.ExportFile{}, err
+	}
+	candidate, err := e.username(c, userID)
+	if err != nil {
+		return domain.ExportFile{}, err
+	}
+
+	report := infrastructure.BuildReport(room, candidate, false)
+	return infrastructure.RenderExport(format, infrastructure.ReportTitle(report), &room, []domain.Room{room}, []domain.Report{report}, time.Now())
+}
+
+// ExportRooms implements domain.ExportRepository.
+// Rooms are exported oldest first, including rooms that are still in progress.
+func (e *exportRepository) ExportRooms(c context.Context, userID primitive.ObjectID, format string) (domain.ExportFile, error) {
+	if err := infrastructure.ValidateExportFormat(format); err != nil {
+		return domain.ExportFile{}, err
+	}
+
+	rooms, err := e.roomRepository.GetRoomsWithUserID(c, userID)
+	if err != nil {
+		return domain.ExportFile{}, err
+	}
+	sort.SliceStable(rooms, func(i, j int) bool { return rooms[i].CreatedAt < rooms[j].CreatedAt })
+	candidate, err := e.username(c, userID)
+	if err != nil {
+		return domain.ExportFile{}, err
+	}
+
+	reports := make([]domain.Report, len(rooms))
+	for i, room := range rooms {
+		reports[i] = infrastructure.BuildReport(room, candidate, false)
+	}
+	return infrastructure.RenderExport(format, fmt.Sprintf("Interviews of %s", candidate), nil, rooms, reports, time.Now())
+}
@@ -24,7 +24,18 @@ func (f *FeedbackRepository) GetFeedback(c context.Context, roomID string) ([]do
 		return nil, err
 	}		
 	
-	filter := bson.M{"room_id": objectID}
+	// Feedback has no bson tags, so its fields are stored under their lowercased names.
+	// Only the room's canonical run is returned; rooms graded before runs existed have none.
+	var room domain.Room
+	err = f.database.Collection(domain.CollectionRoom).FindOne(c, bson.M{"_id": objectID}).Decode(&room)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+
+	filter := bson.M{"roomid": objectID}
+	if !room.CanonicalRunID.IsZero() {
+		filter["runid"] = room.CanonicalRunID
+	}
 	cursor, err := f.database.Collection(f.collection).Find(c, filter)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,272 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// maxGoalEvents is how many of the latest events GetGoalEvents returns
+const maxGoalEvents = 100
+
+type goalRepository struct {
+	database   mongo.Database
+	collection string
+}
+
+func NewGoalRepository(database mongo.Database, collection string) domain.GoalRepository {
+	return &goalRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+func validateGoal(goal domain.Goal) error {
+	switch goal.Type {
+	case domain.GoalTypeRoomsPerWeek, domain.GoalTypeStreak:
+	case domain.GoalTypeReachScore:
+		if goal.Target > 100 {
+			return fmt.Errorf("score goals cannot be above 100")
+		}
+	default:
+		return fmt.Errorf("goal type must be %s, %s or %s", domain.GoalTypeRoomsPerWeek, domain.GoalTypeReachScore, domain.GoalTypeStreak)
+	}
+	if goal.Target <= 0 {
+		return fmt.Errorf("goal target must be positive")
+	}
+	return nil
+}
+
+// CreateGoal implements domain.GoalRepository.
+func (g *goalRepository) CreateGoal(c context.Context, goal domain.Goal) (domain.Goal, error) {
+	if err := validateGoal(goal); err != nil {
+		return domain.Goal{}, err
+	}
+
+	goal.ID = primitive.NewObjectID()
+	goal.Status = domain.GoalStatusActive
+	goal.AchievedAt = 0
+	goal.TimesMet = 0
+	goal.LastMetPeriod = 0
+	goal.CreatedAt = time.Now().Unix()
+
+	collection := g.database.Collection(g.collection)
+	_, err := collection.InsertOne(c, goal)
+	if err != nil {
+		return domain.Goal{}, fmt.Errorf("failed to create goal: %v", err)
+	}
+	return goal, nil
+}
+
+// GetGoals implements domain.GoalRepository.
+func (g *goalRepository) GetGoals(c context.Context, userID primitive.ObjectID) ([]domain.Goal, error) {
+	collection := g.database.Collection(g.collection)
+	cursor, err := collection.Find(c, bson.M{"user_id": userID}, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	goals := []domain.Goal{}
+	if err := cursor.All(c, &goals); err != nil {
+		return nil, err
+	}
+	return goals, nil
+}
+
+// DeleteGoal implements domain.GoalRepository.
+func (g *goalRepository) DeleteGoal(c context.Context, userID primitive.ObjectID, goalID string) error {
+	objectID, err := primitive.ObjectIDFromHex(goalID)
+	if err != nil {
+		return fmt.Errorf("invalid goal ID format: %v", err)
+	}
+
+	collection := g.database.Collection(g.collection)
+	result, err := collection.DeleteOne(c, bson.M{"_id": objectID, "user_id": userID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("goal not found")
+	}
+	return nil
+}
+
+// emit stores an event. Events are the record other parts of the system react to.
+func (g *goalRepository) emit(c context.Context, event domain.GoalEvent) (domain.GoalEvent, error) {
+	event.ID = primitive.NewObjectID()
+	event.CreatedAt = time.Now().Unix()
+
+	_, err := g.database.Collection(domain.CollectionGoalEvent).InsertOne(c, event)
+	if err != nil {
+		return domain.GoalEvent{}, fmt.Errorf("failed to emit goal event: %v", err)
+	}
+	log.Printf("goal event %s for user %s: %s", event.Type, event.UserID.Hex(), event.Message)
+	return event, nil
+}
+
+// EvaluateGoals implements domain.GoalRepository.
+// Goals that are met for the first time (or, for weekly goals, for the first time this
+// week) and newly earned badges are recorded and emitted as events.
+func (g *goalRepository) EvaluateGoals(c context.Context, userID primitive.ObjectID) (domain.GoalSummary, error) {
+	cursor, err := g.database.Collection(domain.CollectionRoom).Find(c, bson.M{"user_id": userID, "status": "completed"})
+	if err != nil {
+		return domain.GoalSummary{}, err
+	}
+	var rooms []domain.Room
+	if err := cursor.All(c, &rooms); err != nil {
+		return domain.GoalSummary{}, err
+	}
+
+	goals, err := g.GetGoals(c, userID)
+	if err != nil {
+		return domain.GoalSummary{}, err
+	}
+
+	now := time.Now()
+	summary := domain.GoalSummary{
+		Goals:  []domain.GoalProgress{},
+		Streak: infrastructure.ComputeStreak(rooms, now),
+		Badges: []domain.Badge{},
+		Events: []domain.GoalEvent{},
+	}
+
+	collection := g.database.Collection(g.collection)
+	for i := range goals {
+		goal := &goals[i]
+		progress := infrastructure.EvaluateGoal(*goal, rooms, summary.Streak, now)
+
+		// The update only matches while the goal is still unmet, so of several evaluations
+		// running at once only one records it and emits the event
+		var filter, update bson.M
+		switch {
+		case !progress.Met || goal.Status == domain.GoalStatusAchieved:
+		case goal.Type == domain.GoalTypeRoomsPerWeek:
+			if goal.LastMetPeriod != progress.PeriodStart {
+				goal.LastMetPeriod = progress.PeriodStart
+				filter = bson.M{"_id": goal.ID, "last_met_period": bson.M{"$ne": goal.LastMetPeriod}}
+				update = bson.M{"$set": bson.M{"last_met_period": goal.LastMetPeriod}, "$inc": bson.M{"times_met": 1}}
+			}
+		default:
+			goal.Status = domain.GoalStatusAchieved
+			goal.AchievedAt = now.Unix()
+			filter = bson.M{"_id": goal.ID, "status": bson.M{"$ne": domain.GoalStatusAchieved}}
+			update = bson.M{"$set": bson.M{"status": goal.Status, "achieved_at": goal.AchievedAt}, "$inc": bson.M{"times_met": 1}}
+		}
+
+		if filter != nil {
+			result, err := collection.UpdateOne(c, filter, update)
+			if err != nil {
+				return domain.GoalSummary{}, fmt.Errorf("failed to update goal: %v", err)
+			}
+			if result.ModifiedCount == 1 {
+				goal.TimesMet++
+				event, err := g.emit(c, domain.GoalEvent{
+					UserID:  userID,
+					Type:    domain.GoalEventGoalMet,
+					GoalID:  goal.ID,
+					Message: fmt.Sprintf("Goal met: %s", infrastructure.DescribeGoal(*goal)),
+				})
+				if err != nil {
+					return domain.GoalSummary{}, err
+				}
+				summary.Events = append(summary.Events, event)
+			}
+		}
+
+		progress.Goal = *goal
+		summary.Goals = append(summary.Goals, progress)
+	}
+
+	badges, events, err := g.awardBadges(c, userID, infrastructure.BuildPracticeStats(rooms, summary.Streak, goals))
+	if err != nil {
+		return domain.GoalSummary{}, err
+	}
+	summary.Badges = badges
+	summary.Events = append(summary.Events, events...)
+	return summary, nil
+}
+
+// awardBadges records the badges whose rule now holds and returns every badge
+func (g *goalRepository) awardBadges(c context.Context, userID primitive.ObjectID, stats infrastructure.PracticeStats) ([]domain.Badge, []domain.GoalEvent, error) {
+	collection := g.database.Collection(domain.CollectionUserBadge)
+	cursor, err := collection.Find(c, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, nil, err
+	}
+	var earned []domain.UserBadge
+	if err := cursor.All(c, &earned); err != nil {
+		return nil, nil, err
+	}
+	earnedAt := make(map[string]int64, len(earned))
+	for _, badge := range earned {
+		earnedAt[badge.Key] = badge.EarnedAt
+	}
+
+	badges := []domain.Badge{}
+	events := []domain.GoalEvent{}
+	for _, rule := range infrastructure.BadgeRules() {
+		badge := rule.Badge
+		badge.EarnedAt = earnedAt[badge.Key]
+
+		if badge.EarnedAt == 0 && rule.Metric(stats) >= rule.Threshold {
+			badge.EarnedAt = time.Now().Unix()
+			// Upserted on the unique {user_id, key} index (see EnsureIndexes), so a badge
+			// earned by concurrent evaluations is stored and emitted once
+			result, err := collection.UpdateOne(
+				c,
+				bson.M{"user_id": userID, "key": badge.Key},
+				bson.M{"$setOnInsert": domain.UserBadge{
+					ID:       primitive.NewObjectID(),
+					UserID:   userID,
+					Key:      badge.Key,
+					EarnedAt: badge.EarnedAt,
+				}},
+				options.Update().SetUpsert(true),
+			)
+			if err != nil && !mongo.IsDuplicateKeyError(err) {
+				return nil, nil, fmt.Errorf("failed to award badge: %v", err)
+			}
+			if err != nil || result.UpsertedCount == 0 {
+				// Another evaluation awarded it first
+				badges = append(badges, badge)
+				continue
+			}
+
+			event, err := g.emit(c, domain.GoalEvent{
+				UserID:   userID,
+				Type:     domain.GoalEventBadgeEarned,
+				BadgeKey: badge.Key,
+				Message:  fmt.Sprintf("Badge earned: %s (%s)", badge.Name, badge.Description),
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			events = append(events, event)
+		}
+		badges = append(badges, badge)
+	}
+	return badges, events, nil
+}
+
+// GetGoalEvents implements domain.GoalRepository.
+func (g *goalRepository) GetGoalEvents(c context.Context, userID primitive.ObjectID) ([]domain.GoalEvent, error) {
+	collection := g.database.Collection(domain.CollectionGoalEvent)
+	cursor, err := collection.Find(c, bson.M{"user_id": userID}, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}).SetLimit(maxGoalEvents))
+	if err != nil {
+		return nil, err
+	}
+
+	events := []domain.GoalEvent{}
+	if err := cursor.All(c, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
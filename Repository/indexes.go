@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EnsureIndexes creates the unique indexes the repositories rely on to record
+// things once when requests race. Existing indexes are left as they are.
+func EnsureIndexes(c context.Context, database mongo.Database) error {
+	unique := []struct {
+		collection string
+		keys       bson.D
+	}{
+		// A badge is earned once per user, see goalRepository.awardBadges
+		{domain.CollectionUserBadge, bson.D{{Key: "user_id", Value: 1}, {Key: "key", Value: 1}}},
+		// A user joins an organization once, see organizationRepository.addMember
+		{domain.CollectionOrganizationMember, bson.D{{Key: "organization_id", Value: 1}, {Key: "user_id", Value: 1}}},
+	}
+	for _, index := range unique {
+		_, err := database.Collection(index.collection).Indexes().CreateOne(c, mongo.IndexModel{
+			Keys:    index.keys,
+			Options: options.Index().SetUnique(true),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create index on %s: %v", index.collection, err)
+		}
+	}
+	return nil
+}
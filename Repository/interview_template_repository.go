@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type interviewTemplateRepository struct {
+	database   mongo.Database
+	collection string
+}
+
+func NewInterviewTemplateRepository(database mongo.Database, collection string) domain.InterviewTemplateRepository {
+	return &interviewTemplateRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+// CreateTemplate implements domain.InterviewTemplateRepository.
+// The template is stored as the next version of its interview type.
+func (r *interviewTemplateRepository) CreateTemplate(c context.Context, template domain.InterviewTemplate) (domain.InterviewTemplate, error) {
+	if template.Type == "" {
+		return domain.InterviewTemplate{}, fmt.Errorf("interview type is required")
+	}
+	if template.Persona == "" {
+		return domain.InterviewTemplate{}, fmt.Errorf("persona is required")
+	}
+	if len(template.Criteria) == 0 {
+		template.Criteria = infrastructure.TemplateCriteria(domain.InterviewTemplate{})
+	}
+	keys := make(map[string]bool)
+	for _, criterion := range template.Criteria {
+		if criterion.Key == "" {
+			return domain.InterviewTemplate{}, fmt.Errorf("every rubric criterion needs a key")
+		}
+		if keys[criterion.Key] {
+			return domain.InterviewTemplate{}, fmt.Errorf("rubric criterion %s is listed twice", criterion.Key)
+		}
+		if criterion.Weight <= 0 {
+			return domain.InterviewTemplate{}, fmt.Errorf("rubric criterion %s needs a positive weight", criterion.Key)
+		}
+		keys[criterion.Key] = true
+	}
+
+	latest, err := r.GetLatestTemplate(c, template.Type)
+	if err != nil && err.Error() != "interview template not found" {
+		return domain.InterviewTemplate{}, err
+	}
+
+	template.ID = primitive.NewObjectID()
+	template.Version = latest.Version + 1
+	template.CreatedAt = time.Now().Unix()
+
+	collection := r.database.Collection(r.collection)
+	_, err = collection.InsertOne(c, template)
+	if err != nil {
+		return domain.InterviewTemplate{}, fmt.Errorf("failed to create interview template: %v", err)
+	}
+
+	return template, nil
+}
+
+// GetTemplates implements domain.InterviewTemplateRepository.
+// It returns the built-in templates followed by every stored version.
+func (r *interviewTemplateRepository) GetTemplates(c context.Context) ([]domain.InterviewTemplate, error) {
+	collection := r.database.Collection(r.collection)
+	cursor, err := collection.Find(c, bson.M{}, options.Find().SetSort(bson.D{{Key: "type", Value: 1}, {Key: "version", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []domain.InterviewTemplate
+	if err := cursor.All(c, &templates); err != nil {
+		return nil, err
+	}
+
+	return append(infrastructure.DefaultInterviewTemplates(), templates...), nil
+}
+
+// GetLatestTemplate implements domain.InterviewTemplateRepository.
+func (r *interviewTemplateRepository) GetLatestTemplate(c context.Context, interviewType string) (domain.InterviewTemplate, error) {
+	collection := r.database.Collection(r.collection)
+	var template domain.InterviewTemplate
+	err := collection.FindOne(
+		c,
+		bson.M{"type": interviewType},
+		options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}}),
+	).Decode(&template)
+	if err == nil {
+		return template, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return domain.InterviewTemplate{}, err
+	}
+
+	if template, ok := infrastructure.DefaultInterviewTemplate(interviewType); ok {
+		return template, nil
+	}
+	return domain.InterviewTemplate{}, fmt.Errorf("interview template not found")
+}
+
+// GetTemplateVersion implements domain.InterviewTemplateRepository.
+func (r *interviewTemplateRepository) GetTemplateVersion(c context.Context, interviewType string, version int) (domain.InterviewTemplate, error) {
+	collection := r.database.Collection(r.collection)
+	var template domain.InterviewTemplate
+	err := collection.FindOne(c, bson.M{"type": interviewType, "version": version}).Decode(&template)
+	if err == nil {
+		return template, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return domain.InterviewTemplate{}, err
+	}
+
+	if template, ok := infrastructure.DefaultInterviewTemplate(interviewType); ok && template.Version == version {
+		return template, nil
+	}
+	return domain.InterviewTemplate{}, fmt.Errorf("interview template not found")
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type messageAudioRepository struct {
+	database     mongo.Database
+	collection   string
+	blobStore    domain.BlobStore
+	textToSpeech domain.TextToSpeech
+}
+
+func NewMessageAudioRepository(database mongo.Database, collection string, blobStore domain.BlobStore, textToSpeech domain.TextToSpeech) domain.MessageAudioRepository {
+	return &messageAudioRepository{
+		database:     database,
+		collection:   collection,
+		blobStore:    blobStore,
+		textToSpeech: textToSpeech,
+	}
+}
+
+// messageAudioKey is the blob key audio for a message is cached under
+func messageAudioKey(userID primitive.ObjectID, messageID primitive.ObjectID) string {
+	return fmt.Sprintf("tts/%s/%s", userID.Hex(), messageID.Hex())
+}
+
+// SpeakMessage implements domain.MessageAudioRepository.
+func (m *messageAudioRepository) SpeakMessage(c context.Context, userID primitive.ObjectID, message domain.Message) (string, error) {
+	if message.Sender != "ai" {
+		return "", fmt.Errorf("only interviewer messages can be spoken")
+	}
+
+	key := messageAudioKey(userID, message.ID)
+	exists, err := m.blobStore.Exists(c, key)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return m.blobStore.URL(key), nil
+	}
+
+	// Panel personas speak with their own voice
+	audio, contentType, err := m.textToSpeech.Synthesize(c, message.Text, message.Persona)
+	if err != nil {
+		return "", fmt.Errorf("failed to synthesize speech: %v", err)
+	}
+
+	audioURL, err := m.blobStore.Put(c, key, contentType, bytes.NewReader(audio))
+	if err != nil {
+		return "", fmt.Errorf("failed to store speech: %v", err)
+	}
+	return audioURL, nil
+}
+
+// GetMessageAudio implements domain.MessageAudioRepository.
+func (m *messageAudioRepository) GetMessageAudio(c context.Context, userID primitive.ObjectID, roomID string, messageID string) (string, error) {
+	roomObjectID, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return "", fmt.Errorf("invalid room ID format: %v", err)
+	}
+	messageObjectID, err := primitive.ObjectIDFromHex(messageID)
+	if err != nil {
+		return "", fmt.Errorf("invalid message ID format: %v", err)
+	}
+
+	collection := m.database.Collection(m.collection)
+	var room domain.Room
+	err = collection.FindOne(c, bson.M{"_id": roomObjectID, "user_id": userID}).Decode(&room)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", fmt.Errorf("room not found")
+		}
+		return "", err
+	}
+
+	for _, message := range room.Messages {
+		if message.ID != messageObjectID {
+			continue
+		}
+
+		audioURL, err := m.SpeakMessage(c, userID, message)
+		if err != nil {
+			return "", err
+		}
+
+		if message.AudioURL != audioURL {
+			_, err = collection.UpdateOne(
+				c,
+				bson.M{"_id": roomObjectID, "messages._id": messageObjectID},
+				bson.M{"$set": bson.M{"messages.$.audio_url": audioURL}},
+			)
+			if err != nil {
+				return "", err
+			}
+		}
+		return audioURL, nil
+	}
+
+	return "", fmt.Errorf("message not found")
+}
+
+// ForgetMessageAudio implements domain.MessageAudioRepository.
+func (m *messageAudioRepository) ForgetMessageAudio(c context.Context, userID primitive.ObjectID, messageID primitive.ObjectID) error {
+	return m.blobStore.Delete(c, messageAudioKey(userID, messageID))
+}
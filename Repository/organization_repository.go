@@ -0,0 +1,548 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// invitationLifetime is how long an invitation can be accepted
+const invitationLifetime = 7 * 24 * time.Hour
+
+type organizationRepository struct {
+	database   mongo.Database
+	collection string
+}
+
+func NewOrganizationRepository(database mongo.Database, collection string) domain.OrganizationRepository {
+	return &organizationRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+func validOrganizationRole(role string) bool {
+	return role == domain.OrganizationRoleOwner || role == domain.OrganizationRoleCoach || role == domain.OrganizationRoleCandidate
+}
+
+// membership loads the acting user's membership. Users outside the organization get
+// "organization not found" so they cannot tell which organizations exist.
+func (o *organizationRepository) membership(c context.Context, userID primitive.ObjectID, organizationID string) (domain.OrganizationMember, error) {
+	objectID, err := primitive.ObjectIDFromHex(organizationID)
+	if err != nil {
+		return domain.OrganizationMember{}, fmt.Errorf("invalid organization ID format: %v", err)
+	}
+
+	var member domain.OrganizationMember
+	err = o.database.Collection(domain.CollectionOrganizationMember).FindOne(c, bson.M{"organization_id": objectID, "user_id": userID}).Decode(&member)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.OrganizationMember{}, fmt.Errorf("organization not found")
+		}
+		return domain.OrganizationMember{}, err
+	}
+	return member, nil
+}
+
+// memberWithRole loads the acting user's membership and checks it has one of the roles
+func (o *organizationRepository) memberWithRole(c context.Context, userID primitive.ObjectID, organizationID string, roles ...string) (domain.OrganizationMember, error) {
+	member, err := o.membership(c, userID, organizationID)
+	if err != nil {
+		return domain.OrganizationMember{}, err
+	}
+	for _, role := range roles {
+		if member.Role == role {
+			return member, nil
+		}
+	}
+	return domain.OrganizationMember{}, fmt.Errorf("your role in this organization does not allow this")
+}
+
+// member loads another user's membership of the organization
+func (o *organizationRepository) member(c context.Context, organizationID primitive.ObjectID, memberUserID string) (domain.OrganizationMember, error) {
+	objectID, err := primitive.ObjectIDFromHex(memberUserID)
+	if err != nil {
+		return domain.OrganizationMember{}, fmt.Errorf("invalid member ID format: %v", err)
+	}
+
+	var member domain.OrganizationMember
+	err = o.database.Collection(domain.CollectionOrganizationMember).FindOne(c, bson.M{"organization_id": organizationID, "user_id": objectID}).Decode(&member)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.OrganizationMember{}, fmt.Errorf("member not found")
+		}
+		return domain.OrganizationMember{}, err
+	}
+	return member, nil
+}
+
+// addMember stores a membership with the user's current name and email
+func (o *organizationRepository) addMember(c context.Context, organizationID, userID primitive.ObjectID, role string) (domain.OrganizationMember, error) {
+	var user domain.User
+	err := o.database.Collection(domain.CollectionUser).FindOne(c, bson.M{"_id": userID}).Decode(&user)
+	if err != nil {
+		return domain.OrganizationMember{}, fmt.Errorf("failed to load user: %v", err)
+	}
+
+	member := domain.OrganizationMember{
+		ID:             primitive.NewObjectID(),
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Username:       user.Username,
+		Email:          strings.ToLower(strings.TrimSpace(user.Email)),
+		Role:           role,
+		JoinedAt:       time.Now().Unix(),
+	}
+	_, err = o.database.Collection(domain.CollectionOrganizationMember).InsertOne(c, member)
+	if mongo.IsDuplicateKeyError(err) {
+		return domain.OrganizationMember{}, fmt.Errorf("user is already a member")
+	}
+	if err != nil {
+		return domain.OrganizationMember{}, fmt.Errorf("failed to add member: %v", err)
+	}
+	return member, nil
+}
+
+// CreateOrganization implements domain.OrganizationRepository.
+// The creator becomes the organization's owner.
+func (o *organizationRepository) CreateOrganization(c context.Context, userID primitive.ObjectID, name string) (domain.Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.Organization{}, fmt.Errorf("organization name is required")
+	}
+
+	organization := domain.Organization{
+		ID:        primitive.NewObjectID(),
+		Name:      name,
+		OwnerID:   userID,
+		CreatedAt: time.Now().Unix(),
+	}
+	_, err := o.database.Collection(o.collection).InsertOne(c, organization)
+	if err != nil {
+		return domain.Organization{}, fmt.Errorf("failed to create organization: %v", err)
+	}
+
+	if _, err := o.addMember(c, organization.ID, userID, domain.OrganizationRoleOwner); err != nil {
+		return domain.Organization{}, err
+	}
+	return organization, nil
+}
+
+// GetOrganizations implements domain.OrganizationRepository.
+// It returns the organizations the user is a member of.
+func (o *organizationRepository) GetOrganizations(c context.Context, userID primitive.ObjectID) ([]domain.Organization, error) {
+	cursor, err := o.database.Collection(domain.CollectionOrganizationMember).Find(c, bson.M{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	var memberships []domain.OrganizationMember
+	if err := cursor.All(c, &memberships); err != nil {
+		return nil, err
+	}
+
+	organizationIDs := make([]primitive.ObjectID, 0, len(memberships))
+	for _, member := range memberships {
+		organizationIDs = append(organizationIDs, member.OrganizationID)
+	}
+
+	organizations := []domain.Organization{}
+	if len(organizationIDs) == 0 {
+		return organizations, nil
+	}
+	cursor, err = o.database.Collection(o.collection).Find(c, bson.M{"_id": bson.M{"$in": organizationIDs}}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	if err := cursor.All(c, &organizations); err != nil {
+		return nil, err
+	}
+	return organizations, nil
+}
+
+// GetMembers implements domain.OrganizationRepository.
+// Owners and coaches see every member; candidates only see themselves.
+func (o *organizationRepository) GetMembers(c context.Context, userID primitive.ObjectID, organizationID string) ([]domain.OrganizationMember, error) {
+	viewer, err := o.membership(c, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if viewer.Role == domain.OrganizationRoleCandidate {
+		return []domain.OrganizationMember{viewer}, nil
+	}
+
+	cursor, err := o.database.Collection(domain.CollectionOrganizationMember).Find(c, bson.M{"organization_id": viewer.OrganizationID}, options.Find().SetSort(bson.D{{Key: "joined_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	members := []domain.OrganizationMember{}
+	if err := cursor.All(c, &members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// countOwners counts the owners of an organization
+func (o *organizationRepository) countOwners(c context.Context, organizationID primitive.ObjectID) (int64, error) {
+	return o.database.Collection(domain.CollectionOrganizationMember).CountDocuments(c, bson.M{"organization_id": organizationID, "role": domain.OrganizationRoleOwner})
+}
+
+// UpdateMemberRole implements domain.OrganizationRepository.
+// Only owners change roles, and an organization always keeps at least one owner.
+func (o *organizationRepository) UpdateMemberRole(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string, role string) (domain.OrganizationMember, error) {
+	if !validOrganizationRole(role) {
+		return domain.OrganizationMember{}, fmt.Errorf("role must be %s, %s or %s", domain.OrganizationRoleOwner, domain.OrganizationRoleCoach, domain.OrganizationRoleCandidate)
+	}
+	viewer, err := o.memberWithRole(c, userID, organizationID, domain.OrganizationRoleOwner)
+	if err != nil {
+		return domain.OrganizationMember{}, err
+	}
+	member, err := o.member(c, viewer.OrganizationID, memberUserID)
+	if err != nil {
+		return domain.OrganizationMember{}, err
+	}
+
+	if member.Role == domain.OrganizationRoleOwner && role != domain.OrganizationRoleOwner {
+		owners, err := o.countOwners(c, member.OrganizationID)
+		if err != nil {
+			return domain.OrganizationMember{}, err
+		}
+		if owners <= 1 {
+			return domain.OrganizationMember{}, fmt.Errorf("an organization needs at least one owner")
+		}
+	}
+
+	member.Role = role
+	_, err = o.database.Collection(domain.CollectionOrganizationMember).UpdateOne(c, bson.M{"_id": member.ID}, bson.M{"$set": bson.M{"role": role}})
+	if err != nil {
+		return domain.OrganizationMember{}, err
+	}
+	return member, nil
+}
+
+// RemoveMember implements domain.OrganizationRepository.
+// Owners remove anyone; every member can remove themselves to leave.
+func (o *organizationRepository) RemoveMember(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string) error {
+	viewer, err := o.membership(c, userID, organizationID)
+	if err != nil {
+		return err
+	}
+	if viewer.Role != domain.OrganizationRoleOwner && viewer.UserID.Hex() != memberUserID {
+		return fmt.Errorf("your role in this organization does not allow this")
+	}
+	member, err := o.member(c, viewer.OrganizationID, memberUserID)
+	if err != nil {
+		return err
+	}
+
+	if member.Role == domain.OrganizationRoleOwner {
+		owners, err := o.countOwners(c, member.OrganizationID)
+		if err != nil {
+			return err
+		}
+		if owners <= 1 {
+			return fmt.Errorf("an organization needs at least one owner")
+		}
+	}
+
+	_, err = o.database.Collection(domain.CollectionOrganizationMember).DeleteOne(c, bson.M{"_id": member.ID})
+	return err
+}
+
+// CreateInvitation implements domain.OrganizationRepository.
+// Owners invite any role; coaches only invite candidates.
+func (o *organizationRepository) CreateInvitation(c context.Context, userID primitive.ObjectID, organizationID string, request domain.InvitationRequest) (domain.Invitation, error) {
+	email := strings.ToLower(strings.TrimSpace(request.Email))
+	if !strings.Contains(email, "@") {
+		return domain.Invitation{}, fmt.Errorf("a valid email is required")
+	}
+	if !validOrganizationRole(request.Role) {
+		return domain.Invitation{}, fmt.Errorf("role must be %s, %s or %s", domain.OrganizationRoleOwner, domain.OrganizationRoleCoach, domain.OrganizationRoleCandidate)
+	}
+
+	viewer, err := o.memberWithRole(c, userID, organizationID, domain.OrganizationRoleOwner, domain.OrganizationRoleCoach)
+	if err != nil {
+		return domain.Invitation{}, err
+	}
+	if viewer.Role == domain.OrganizationRoleCoach && request.Role != domain.OrganizationRoleCandidate {
+		return domain.Invitation{}, fmt.Errorf("coaches can only invite candidates")
+	}
+
+	members := o.database.Collection(domain.CollectionOrganizationMember)
+	count, err := members.CountDocuments(c, bson.M{"organization_id": viewer.OrganizationID, "email": email})
+	if err != nil {
+		return domain.Invitation{}, err
+	}
+	if count > 0 {
+		return domain.Invitation{}, fmt.Errorf("user is already a member")
+	}
+
+	var organization domain.Organization
+	if err := o.database.Collection(o.collection).FindOne(c, bson.M{"_id": viewer.OrganizationID}).Decode(&organization); err != nil {
+		return domain.Invitation{}, fmt.Errorf("failed to load organization: %v", err)
+	}
+
+	now := time.Now()
+	invitation := domain.Invitation{
+		ID:               primitive.NewObjectID(),
+		OrganizationID:   organization.ID,
+		OrganizationName: organization.Name,
+		Email:            email,
+		Role:             request.Role,
+		InvitedBy:        userID,
+		Status:           domain.InvitationStatusPending,
+		ExpiresAt:        now.Add(invitationLifetime).Unix(),
+		CreatedAt:        now.Unix(),
+	}
+
+	// A new invitation replaces a pending one for the same email
+	invitations := o.database.Collection(domain.CollectionInvitation)
+	_, err = invitations.UpdateMany(c,
+		bson.M{"organization_id": organization.ID, "email": email, "status": domain.InvitationStatusPending},
+		bson.M{"$set": bson.M{"status": domain.InvitationStatusRevoked}},
+	)
+	if err != nil {
+		return domain.Invitation{}, err
+	}
+	_, err = invitations.InsertOne(c, invitation)
+	if err != nil {
+		return domain.Invitation{}, fmt.Errorf("failed to create invitation: %v", err)
+	}
+	return invitation, nil
+}
+
+// GetOrganizationInvitations implements domain.OrganizationRepository.
+func (o *organizationRepository) GetOrganizationInvitations(c context.Context, userID primitive.ObjectID, organizationID string) ([]domain.Invitation, error) {
+	viewer, err := o.memberWithRole(c, userID, organizationID, domain.OrganizationRoleOwner, domain.OrganizationRoleCoach)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := o.database.Collection(domain.CollectionInvitation).Find(c, bson.M{"organization_id": viewer.OrganizationID}, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	invitations := []domain.Invitation{}
+	if err := cursor.All(c, &invitations); err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// RevokeInvitation implements domain.OrganizationRepository.
+func (o *organizationRepository) RevokeInvitation(c context.Context, userID primitive.ObjectID, organizationID string, invitationID string) error {
+	viewer, err := o.memberWithRole(c, userID, organizationID, domain.OrganizationRoleOwner, domain.OrganizationRoleCoach)
+	if err != nil {
+		return err
+	}
+	objectID, err := primitive.ObjectIDFromHex(invitationID)
+	if err != nil {
+		return fmt.Errorf("invalid invitation ID format: %v", err)
+	}
+
+	result, err := o.database.Collection(domain.CollectionInvitation).UpdateOne(c,
+		bson.M{"_id": objectID, "organization_id": viewer.OrganizationID, "status": domain.InvitationStatusPending},
+		bson.M{"$set": bson.M{"status": domain.InvitationStatusRevoked}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("invitation not found")
+	}
+	return nil
+}
+
+// userEmail returns the email of a user, lower-cased like invitation emails
+func (o *organizationRepository) userEmail(c context.Context, userID primitive.ObjectID) (string, error) {
+	var user domain.User
+	err := o.database.Collection(domain.CollectionUser).FindOne(c, bson.M{"_id": userID}).Decode(&user)
+	if err != nil {
+		return "", fmt.Errorf("failed to load user: %v", err)
+	}
+	return strings.ToLower(strings.TrimSpace(user.Email)), nil
+}
+
+// GetMyInvitations implements domain.OrganizationRepository.
+// It returns the pending, unexpired invitations sent to the user's email.
+func (o *organizationRepository) GetMyInvitations(c context.Context, userID primitive.ObjectID) ([]domain.Invitation, error) {
+	email, err := o.userEmail(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := o.database.Collection(domain.CollectionInvitation).Find(c, bson.M{
+		"email":      email,
+		"status":     domain.InvitationStatusPending,
+		"expires_at": bson.M{"$gt": time.Now().Unix()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	invitations := []domain.Invitation{}
+	if err := cursor.All(c, &invitations); err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// AcceptInvitation implements domain.OrganizationRepository.
+// Only the user the invitation was sent to can accept it.
+func (o *organizationRepository) AcceptInvitation(c context.Context, userID primitive.ObjectID, invitationID string) (domain.OrganizationMember, error) {
+	objectID, err := primitive.ObjectIDFromHex(invitationID)
+	if err != nil {
+		return domain.OrganizationMember{}, fmt.Errorf("invalid invitation ID format: %v", err)
+	}
+	email, err := o.userEmail(c, userID)
+	if err != nil {
+		return domain.OrganizationMember{}, err
+	}
+
+	invitations := o.database.Collection(domain.CollectionInvitation)
+	var invitation domain.Invitation
+	err = invitations.FindOne(c, bson.M{"_id": objectID, "email": email, "status": domain.InvitationStatusPending}).Decode(&invitation)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.OrganizationMember{}, fmt.Errorf("invitation not found")
+		}
+		return domain.OrganizationMember{}, err
+	}
+	now := time.Now().Unix()
+	if invitation.ExpiresAt <= now {
+		return domain.OrganizationMember{}, fmt.Errorf("invitation has expired")
+	}
+
+	if _, err := o.member(c, invitation.OrganizationID, userID.Hex()); err == nil {
+		return domain.OrganizationMember{}, fmt.Errorf("user is already a member")
+	} else if err.Error() != "member not found" {
+		return domain.OrganizationMember{}, err
+	}
+
+	// Claim the invitation first, so concurrent accepts add the member once
+	result, err := invitations.UpdateOne(
+		c,
+		bson.M{"_id": invitation.ID, "status": domain.InvitationStatusPending},
+		bson.M{"$set": bson.M{"status": domain.InvitationStatusAccepted, "accepted_at": now}},
+	)
+	if err != nil {
+		return domain.OrganizationMember{}, err
+	}
+	if result.ModifiedCount == 0 {
+		return domain.OrganizationMember{}, fmt.Errorf("invitation not found")
+	}
+
+	member, err := o.addMember(c, invitation.OrganizationID, userID, invitation.Role)
+	if err != nil {
+		// Hand the invitation back so it can be accepted again
+		if _, releaseErr := invitations.UpdateOne(
+			c,
+			bson.M{"_id": invitation.ID, "status": domain.InvitationStatusAccepted, "accepted_at": now},
+			bson.M{"$set": bson.M{"status": domain.InvitationStatusPending}, "$unset": bson.M{"accepted_at": ""}},
+		); releaseErr != nil {
+			log.Printf("failed to release invitation %s: %v", invitation.ID.Hex(), releaseErr)
+		}
+		return domain.OrganizationMember{}, err
+	}
+	return member, nil
+}
+
+// coachedCandidate checks that the acting user coaches in the organization and that
+// the member is one of its candidates. This is the only way to read another user's rooms.
+func (o *organizationRepository) coachedCandidate(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string) (domain.OrganizationMember, error) {
+	viewer, err := o.memberWithRole(c, userID, organizationID, domain.OrganizationRoleOwner, domain.OrganizationRoleCoach)
+	if err != nil {
+		return domain.OrganizationMember{}, err
+	}
+	member, err := o.member(c, viewer.OrganizationID, memberUserID)
+	if err != nil {
+		return domain.OrganizationMember{}, err
+	}
+	if member.Role != domain.OrganizationRoleCandidate {
+		return domain.OrganizationMember{}, fmt.Errorf("only candidates' rooms can be reviewed")
+	}
+	return member, nil
+}
+
+// GetMemberRooms implements domain.OrganizationRepository.
+func (o *organizationRepository) GetMemberRooms(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string) ([]domain.Room, error) {
+	member, err := o.coachedCandidate(c, userID, organizationID, memberUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := o.database.Collection(domain.CollectionRoom).Find(c, bson.M{"user_id": member.UserID}, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	rooms := []domain.Room{}
+	if err := cursor.All(c, &rooms); err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
+
+// GetMemberRoom implements domain.OrganizationRepository.
+func (o *organizationRepository) GetMemberRoom(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string, roomID string) (domain.Room, error) {
+	member, err := o.coachedCandidate(c, userID, organizationID, memberUserID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	objectID, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return domain.Room{}, fmt.Errorf("invalid room ID format: %v", err)
+	}
+
+	var room domain.Room
+	err = o.database.Collection(domain.CollectionRoom).FindOne(c, bson.M{"_id": objectID, "user_id": member.UserID}).Decode(&room)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.Room{}, fmt.Errorf("room not found")
+		}
+		return domain.Room{}, err
+	}
+	return room, nil
+}
+
+// CoachMembership implements domain.OrganizationRepository.
+// It returns the coach's owner or coach membership of an organization where the
+// candidate is a candidate, so coaching tools outside organization routes can check access.
+func (o *organizationRepository) CoachMembership(c context.Context, coachID primitive.ObjectID, candidateID primitive.ObjectID) (domain.OrganizationMember, error) {
+	members := o.database.Collection(domain.CollectionOrganizationMember)
+	cursor, err := members.Find(c, bson.M{"user_id": candidateID, "role": domain.OrganizationRoleCandidate})
+	if err != nil {
+		return domain.OrganizationMember{}, err
+	}
+	var candidacies []domain.OrganizationMember
+	if err := cursor.All(c, &candidacies); err != nil {
+		return domain.OrganizationMember{}, err
+	}
+	if len(candidacies) == 0 {
+		return domain.OrganizationMember{}, fmt.Errorf("you do not coach this candidate")
+	}
+
+	organizationIDs := make([]primitive.ObjectID, len(candidacies))
+	for i, candidacy := range candidacies {
+		organizationIDs[i] = candidacy.OrganizationID
+	}
+
+	var coach domain.OrganizationMember
+	err = members.FindOne(c, bson.M{
+		"user_id":         coachID,
+		"organization_id": bson.M{"$in": organizationIDs},
+		"role":            bson.M{"$in": []string{domain.OrganizationRoleOwner, domain.OrganizationRoleCoach}},
+	}).Decode(&coach)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.OrganizationMember{}, fmt.Errorf("you do not coach this candidate")
+		}
+		return domain.OrganizationMember{}, err
+	}
+	return coach, nil
+}
@@ -2,8 +2,8 @@ package repository
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"reflect"
 	"time"
 
 	domain "github.com/chachidani/interview-coach-backend/Domain"
@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type OverallFeedbackRepository struct {
@@ -53,69 +54,107 @@ func (r *OverallFeedbackRepository) CreateOverallFeedback(c context.Context, ove
 		return fmt.Errorf("no completed rooms found for user")
 	}
 
-	// Build message history for all completed rooms
-	messageHistory := infrastructure.BuildRoomsMessageHistory(completedRooms)
-
-	// Generate overall feedback using Gemini
-	prompt := fmt.Sprintf(`You are an AI interviewer providing overall feedback. Analyze the following interview sessions and provide comprehensive feedback.
-
-Interview History:
-%s
-
-Please provide:
-1. List of overall strengths across all interviews
-2. Areas that need improvement
-3. Top performing topic/area
-4. Overall score percentage (0-100)
-
-Format your response as JSON:
-{
-    "strength": ["strength1", "strength2"],
-    "improvement": ["improvement1", "improvement2"],
-    "top_topic": "topic name",
-    "score_percentage": 85
-}`, messageHistory)
-
-	geminiRequest := domain.GeminiRequest{
-		Contents: []struct {
-			Parts []struct {
-				Text string `json:"text"`
-			} `json:"parts"`
-		}{
-			{
-				Parts: []struct {
-					Text string `json:"text"`
-				}{
-					{Text: prompt},
-				},
-			},
-		},
-	}
-
-	feedbackResponse, err := r.geminiRepository.GenerateResponse(geminiRequest)
+	// Only rooms completed since the latest snapshot are summarised, unless rooms it
+	// covers were rescored or deleted since, which makes its narrative stale
+	previous, err := r.latestOverallFeedback(c, overallFeedback.UserID)
 	if err != nil {
-		return fmt.Errorf("failed to generate overall feedback: %v", err)
+		return err
 	}
 
-	// Parse the JSON response
-	var feedbackData struct {
-		Strength        []string `json:"strength"`
-		Improvement     []string `json:"improvement"`
-		TopTopic        string   `json:"top_topic"`
-		ScorePercentage int      `json:"score_percentage"`
+	fingerprints := make(map[string]string, len(completedRooms))
+	var roomIDs []primitive.ObjectID
+	var newRooms []domain.Room
+	stale := false
+	for _, room := range completedRooms {
+		fingerprints[room.ID.Hex()] = roomFingerprint(room)
+		roomIDs = append(roomIDs, room.ID)
+		if previous == nil {
+			newRooms = append(newRooms, room)
+			continue
+		}
+		covered, found := previous.RoomFingerprints[room.ID.Hex()]
+		if !found && !containsObjectID(previous.RoomIDs, room.ID) {
+			newRooms = append(newRooms, room)
+		} else if covered != fingerprints[room.ID.Hex()] {
+			stale = true
+		}
+	}
+	if previous != nil && len(previous.RoomIDs) > len(completedRooms)-len(newRooms) {
+		stale = true
 	}
 
-	if err := json.Unmarshal([]byte(feedbackResponse), &feedbackData); err != nil {
-		return fmt.Errorf("failed to parse feedback response: %v", err)
+	// The numbers are computed from the stored rooms on every request, never by the model,
+	// so started interviews count even when the narrative has nothing new to summarise
+	metrics := infrastructure.ComputeOverallMetrics(rooms)
+	narrativeCurrent := len(newRooms) == 0 && !stale
+	if narrativeCurrent && reflect.DeepEqual(metrics, snapshotMetrics(*previous)) {
+		return fmt.Errorf("overall feedback is already up to date")
+	}
+
+	summarisedRooms, base := newRooms, previous
+	if stale {
+		summarisedRooms, base = completedRooms, nil
 	}
 
-	// Update overall feedback with AI-generated data
 	overallFeedback.ID = primitive.NewObjectID()
-	overallFeedback.Strength = feedbackData.Strength
-	overallFeedback.Improvement = feedbackData.Improvement
-	overallFeedback.TopTopic = feedbackData.TopTopic
-	overallFeedback.ScorePercentage = feedbackData.ScorePercentage
-	overallFeedback.TotalInterview = int64(len(completedRooms))
+	if narrativeCurrent {
+		overallFeedback.Strength = previous.Strength
+		overallFeedback.Improvement = previous.Improvement
+	} else {
+		// Summarise the stored feedback of the rooms instead of their transcripts
+		summaries, err := r.condenseSummaries(summarisedRooms)
+		if err != nil {
+			return err
+		}
+
+		prompt := infrastructure.BuildOverallFeedbackPrompt(base, summaries)
+		feedbackResponse, err := r.geminiRepository.GenerateResponse(infrastructure.BuildGeminiRequest(prompt))
+		if err != nil {
+			return fmt.Errorf("failed to generate overall feedback: %v", err)
+		}
+
+		feedbackData, err := infrastructure.ParseOverallFeedback(feedbackResponse)
+		if err != nil {
+			return err
+		}
+
+		// Update overall feedback with AI-generated narrative
+		overallFeedback.Strength = feedbackData.Strength
+		overallFeedback.Improvement = feedbackData.Improvement
+	}
+	overallFeedback.RoomIDs = roomIDs
+	overallFeedback.RoomFingerprints = fingerprints
+
+	overallFeedback.ScorePercentage = metrics.ScorePercentage
+	overallFeedback.AverageScore = metrics.AverageScore
+	overallFeedback.TopicScores = metrics.TopicScores
+	overallFeedback.TopTopic = metrics.TopTopic
+	overallFeedback.WeakestTopic = metrics.WeakestTopic
+	overallFeedback.TotalInterview = metrics.TotalInterview
+	overallFeedback.StartedInterviews = metrics.StartedInterviews
+	overallFeedback.CompletionRate = metrics.CompletionRate
+	overallFeedback.CoachScoredRooms = metrics.CoachScoredRooms
+
+	// Aggregate spoken delivery over every voice answer, carrying over the previous snapshot unless it is rebuilt
+	if previous != nil {
+		overallFeedback.PreviousID = previous.ID
+	}
+	if narrativeCurrent {
+		overallFeedback.DeliveryMetrics = previous.DeliveryMetrics
+	} else {
+		var deliveryMetrics []domain.DeliveryMetrics
+		if base != nil && base.DeliveryMetrics != nil {
+			deliveryMetrics = append(deliveryMetrics, *base.DeliveryMetrics)
+		}
+		for _, room := range summarisedRooms {
+			for _, feedback := range room.Feedback {
+				if feedback.DeliveryMetrics != nil {
+					deliveryMetrics = append(deliveryMetrics, *feedback.DeliveryMetrics)
+				}
+			}
+		}
+		overallFeedback.DeliveryMetrics = infrastructure.AggregateDeliveryMetrics(deliveryMetrics)
+	}
 	overallFeedback.CreatedAt = time.Now().Unix()
 
 	// Save to database
@@ -128,6 +167,89 @@ Format your response as JSON:
 	return nil
 }
 
+// snapshotMetrics returns the numbers a snapshot was saved with
+func snapshotMetrics(feedback domain.OverallFeedback) infrastructure.OverallMetrics {
+	return infrastructure.OverallMetrics{
+		ScorePercentage:   feedback.ScorePercentage,
+		AverageScore:      feedback.AverageScore,
+		TopicScores:       feedback.TopicScores,
+		TopTopic:          feedback.TopTopic,
+		WeakestTopic:      feedback.WeakestTopic,
+		TotalInterview:    feedback.TotalInterview,
+		StartedInterviews: feedback.StartedInterviews,
+		CompletionRate:    feedback.CompletionRate,
+		CoachScoredRooms:  feedback.CoachScoredRooms,
+	}
+}
+
+// roomFingerprint identifies the score a snapshot saw for a room, so rescoring it
+// (a new canonical feedback run or a coach override) can be noticed later
+func roomFingerprint(room domain.Room) string {
+	return fmt.Sprintf("%s:%d", room.CanonicalRunID.Hex(), room.PerformancePercentage)
+}
+
+func containsObjectID(ids []primitive.ObjectID, id primitive.ObjectID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
+// latestOverallFeedback returns the user's most recent snapshot, or nil when there is none.
+// OverallFeedback has no bson tags, so its fields are stored under their lowercased names.
+func (r *OverallFeedbackRepository) latestOverallFeedback(c context.Context, userID primitive.ObjectID) (*domain.OverallFeedback, error) {
+	var previous domain.OverallFeedback
+	err := r.database.Collection(r.collection).FindOne(
+		c,
+		bson.M{"userid": userID},
+		options.FindOne().SetSort(bson.D{{Key: "createdat", Value: -1}}),
+	).Decode(&previous)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load previous overall feedback: %v", err)
+	}
+	return &previous, nil
+}
+
+// condenseSummaries summarises the rooms and, while the summaries are too long for one
+// prompt, condenses them batch by batch (map) into shorter partial feedback (reduce)
+func (r *OverallFeedbackRepository) condenseSummaries(rooms []domain.Room) (string, error) {
+	summaries := make([]string, 0, len(rooms))
+	for _, room := range rooms {
+		summaries = append(summaries, infrastructure.SummarizeRoomFeedback(room))
+	}
+
+	chunks := infrastructure.ChunkSummaries(summaries, infrastructure.MaxOverallFeedbackPromptChars)
+	for len(chunks) > 1 {
+		condensed := make([]string, 0, len(chunks))
+		for _, chunk := range chunks {
+			response, err := r.geminiRepository.GenerateResponse(infrastructure.BuildGeminiRequest(infrastructure.BuildCondenseFeedbackPrompt(chunk)))
+			if err != nil {
+				return "", fmt.Errorf("failed to condense feedback: %v", err)
+			}
+			result, err := infrastructure.ParseOverallFeedback(response)
+			if err != nil {
+				return "", err
+			}
+			condensed = append(condensed, infrastructure.DescribeCondensedFeedback(result))
+		}
+		next := infrastructure.ChunkSummaries(condensed, infrastructure.MaxOverallFeedbackPromptChars)
+		if len(next) >= len(chunks) {
+			return "", fmt.Errorf("feedback history could not be condensed")
+		}
+		chunks = next
+	}
+
+	if len(chunks) == 0 {
+		return "", nil
+	}
+	return chunks[0], nil
+}
+
 func (r *OverallFeedbackRepository) GetOverallFeedback(c context.Context, userID primitive.ObjectID) ([]domain.OverallFeedback, error) {
 	// Validate user ID
 	if userID.IsZero() {
@@ -137,7 +259,7 @@ func (r *OverallFeedbackRepository) GetOverallFeedback(c context.Context, userID
 	collection := r.database.Collection(r.collection)
 	var feedbacks []domain.OverallFeedback
 
-	cursor, err := collection.Find(c, bson.M{"user_id": userID})
+	cursor, err := collection.Find(c, bson.M{"userid": userID}, options.Find().SetSort(bson.D{{Key: "createdat", Value: 1}}))
 	if err != nil {
 		return nil, fmt.Errorf("failed to find overall feedbacks: %v", err)
 	}
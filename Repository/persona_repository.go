@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var personaKeyPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type personaRepository struct {
+	database   mongo.Database
+	collection string
+}
+
+func NewPersonaRepository(database mongo.Database, collection string) domain.PersonaRepository {
+	return &personaRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+func validatePersona(persona domain.Persona) error {
+	if !personaKeyPattern.MatchString(persona.Key) {
+		return fmt.Errorf("persona key must only contain lowercase letters, digits and underscores")
+	}
+	if persona.Name == "" {
+		return fmt.Errorf("persona name is required")
+	}
+	if persona.Description == "" {
+		return fmt.Errorf("persona description is required")
+	}
+	return nil
+}
+
+// CreatePersona implements domain.PersonaRepository.
+func (p *personaRepository) CreatePersona(c context.Context, persona domain.Persona) (domain.Persona, error) {
+	if err := validatePersona(persona); err != nil {
+		return domain.Persona{}, err
+	}
+
+	collection := p.database.Collection(p.collection)
+	count, err := collection.CountDocuments(c, bson.M{"key": persona.Key})
+	if err != nil {
+		return domain.Persona{}, err
+	}
+	if count > 0 {
+		return domain.Persona{}, fmt.Errorf("persona with key %s already exists", persona.Key)
+	}
+
+	persona.ID = primitive.NewObjectID()
+	persona.CreatedAt = time.Now().Unix()
+	persona.UpdatedAt = persona.CreatedAt
+	if persona.Focus == nil {
+		persona.Focus = []string{}
+	}
+
+	_, err = collection.InsertOne(c, persona)
+	if err != nil {
+		return domain.Persona{}, fmt.Errorf("failed to create persona: %v", err)
+	}
+	return persona, nil
+}
+
+// GetPersonas implements domain.PersonaRepository.
+// Stored personas override built-in personas with the same key.
+func (p *personaRepository) GetPersonas(c context.Context) ([]domain.Persona, error) {
+	collection := p.database.Collection(p.collection)
+	cursor, err := collection.Find(c, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	var stored []domain.Persona
+	if err := cursor.All(c, &stored); err != nil {
+		return nil, err
+	}
+
+	storedKeys := make(map[string]bool)
+	for _, persona := range stored {
+		storedKeys[persona.Key] = true
+	}
+
+	personas := []domain.Persona{}
+	for _, persona := range infrastructure.DefaultPersonas() {
+		if !storedKeys[persona.Key] {
+			personas = append(personas, persona)
+		}
+	}
+	return append(personas, stored...), nil
+}
+
+// GetPersona implements domain.PersonaRepository.
+func (p *personaRepository) GetPersona(c context.Context, key string) (domain.Persona, error) {
+	collection := p.database.Collection(p.collection)
+	var persona domain.Persona
+	err := collection.FindOne(c, bson.M{"key": key}).Decode(&persona)
+	if err == nil {
+		return persona, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return domain.Persona{}, err
+	}
+
+	if persona, ok := infrastructure.DefaultPersona(key); ok {
+		return persona, nil
+	}
+	return domain.Persona{}, fmt.Errorf("persona not found")
+}
+
+// UpdatePersona implements domain.PersonaRepository.
+// Updating a built-in persona stores an override for it.
+func (p *personaRepository) UpdatePersona(c context.Context, key string, persona domain.Persona) (domain.Persona, error) {
+	existing, err := p.GetPersona(c, key)
+	if err != nil {
+		return domain.Persona{}, err
+	}
+
+	persona.Key = key
+	if err := validatePersona(persona); err != nil {
+		return domain.Persona{}, err
+	}
+
+	if existing.ID.IsZero() {
+		return p.CreatePersona(c, persona)
+	}
+
+	persona.ID = existing.ID
+	persona.CreatedAt = existing.CreatedAt
+	persona.UpdatedAt = time.Now().Unix()
+	if persona.Focus == nil {
+		persona.Focus = []string{}
+	}
+
+	collection := p.database.Collection(p.collection)
+	_, err = collection.UpdateOne(c, bson.M{"_id": existing.ID}, bson.M{"$set": persona})
+	if err != nil {
+		return domain.Persona{}, err
+	}
+	return persona, nil
+}
+
+// DeletePersona implements domain.PersonaRepository.
+// Deleting an override of a built-in persona restores the built-in one.
+// Custom personas that rooms still run with cannot be deleted.
+func (p *personaRepository) DeletePersona(c context.Context, key string) error {
+	if _, ok := infrastructure.DefaultPersona(key); !ok {
+		count, err := p.database.Collection(domain.CollectionRoom).CountDocuments(c, bson.M{"personas": key})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("persona is used by existing rooms")
+		}
+	}
+
+	collection := p.database.Collection(p.collection)
+	result, err := collection.DeleteOne(c, bson.M{"key": key})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		if _, ok := infrastructure.DefaultPersona(key); ok {
+			return fmt.Errorf("built-in personas cannot be deleted")
+		}
+		return fmt.Errorf("persona not found")
+	}
+	return nil
+}
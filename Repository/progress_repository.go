@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Defaults and limits of a progress report
+const (
+	defaultProgressWeeks  = 12
+	maxProgressWeeks      = 104
+	defaultProgressWindow = 4
+	progressAreaCount     = 3
+)
+
+type progressRepository struct {
+	database   mongo.Database
+	collection string
+}
+
+func NewProgressRepository(database mongo.Database, collection string) domain.ProgressRepository {
+	return &progressRepository{
+		database:   database,
+		collection: collection,
+	}
+}
+
+// progressBucket is one result document of trendPipeline
+type progressBucket struct {
+	ID struct {
+		Key  string    `bson:"key"`
+		Week time.Time `bson:"week"`
+	} `bson:"_id"`
+	Average       float64 `bson:"average"`
+	MovingAverage float64 `bson:"moving_average"`
+	Count         int     `bson:"count"`
+}
+
+// trendPipeline groups the user's completed rooms into weekly buckets per key and
+// adds a trailing moving average over the last window weeks of each key (MongoDB 5.0 or newer).
+// prepare stages run just before grouping, to reshape rooms whose key is nested.
+func trendPipeline(userID primitive.ObjectID, since int64, window int, key interface{}, score string, prepare ...bson.D) mongo.Pipeline {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID, "status": "completed"}}},
+		// Rooms completed before completion times were stored fall back to their creation time
+		{{Key: "$addFields", Value: bson.M{"finished_at": bson.M{"$ifNull": bson.A{"$completed_at", "$created_at"}}}}},
+		{{Key: "$match", Value: bson.M{"finished_at": bson.M{"$gte": since}}}},
+	}
+	pipeline = append(pipeline, prepare...)
+
+	return append(pipeline,
+		bson.D{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"key": key,
+				"week": bson.M{"$dateTrunc": bson.M{
+					"date":        bson.M{"$toDate": bson.M{"$multiply": bson.A{"$finished_at", 1000}}},
+					"unit":        "week",
+					"startOfWeek": "monday",
+				}},
+			},
+			"average": bson.M{"$avg": score},
+			"count":   bson.M{"$sum": 1},
+		}}},
+		bson.D{{Key: "$setWindowFields", Value: bson.M{
+			"partitionBy": "$_id.key",
+			"sortBy":      bson.M{"_id.week": 1},
+			"output": bson.M{"moving_average": bson.M{
+				"$avg":   "$average",
+				// A range over weeks, so weeks without rooms still count towards the window
+				"window": bson.M{"range": bson.A{-(window - 1), 0}, "unit": "week"},
+			}},
+		}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "_id.key", Value: 1}, {Key: "_id.week", Value: 1}}}},
+	)
+}
+
+// trends runs a trend pipeline and turns its buckets into one series per key
+func (r *progressRepository) trends(c context.Context, pipeline mongo.Pipeline) ([]domain.ProgressSeries, error) {
+	cursor, err := r.database.Collection(r.collection).Aggregate(c, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var buckets []progressBucket
+	if err := cursor.All(c, &buckets); err != nil {
+		return nil, err
+	}
+
+	series := []domain.ProgressSeries{}
+	var totals []float64
+	for _, bucket := range buckets {
+		if len(series) == 0 || series[len(series)-1].Key != bucket.ID.Key {
+			series = append(series, domain.ProgressSeries{Key: bucket.ID.Key, Points: []domain.ProgressPoint{}})
+			totals = append(totals, 0)
+		}
+		current := &series[len(series)-1]
+		current.Points = append(current.Points, domain.ProgressPoint{
+			WeekStart:     bucket.ID.Week.Unix(),
+			AverageScore:  roundScore(bucket.Average),
+			MovingAverage: roundScore(bucket.MovingAverage),
+			Count:         bucket.Count,
+		})
+		current.Count += bucket.Count
+		totals[len(totals)-1] += bucket.Average * float64(bucket.Count)
+	}
+	for i := range series {
+		series[i].AverageScore = roundScore(totals[i] / float64(series[i].Count))
+	}
+	return series, nil
+}
+
+// GetProgress implements domain.ProgressRepository.
+func (r *progressRepository) GetProgress(c context.Context, userID primitive.ObjectID, query domain.ProgressQuery) (domain.ProgressReport, error) {
+	if query.Weeks <= 0 {
+		query.Weeks = defaultProgressWeeks
+	}
+	if query.Weeks > maxProgressWeeks {
+		query.Weeks = maxProgressWeeks
+	}
+	if query.Window <= 0 {
+		query.Window = defaultProgressWindow
+	}
+
+	now := time.Now()
+	since := now.AddDate(0, 0, -7*query.Weeks).Unix()
+	report := domain.ProgressReport{
+		UserID:      userID,
+		Weeks:       query.Weeks,
+		Window:      query.Window,
+		Overall:     domain.ProgressSeries{Points: []domain.ProgressPoint{}},
+		GeneratedAt: now.Unix(),
+	}
+
+	overall, err := r.trends(c, trendPipeline(userID, since, query.Window, "", "$performance_percentage"))
+	if err != nil {
+		return domain.ProgressReport{}, err
+	}
+	if len(overall) > 0 {
+		report.Overall = overall[0]
+	}
+
+	if report.Topics, err = r.trends(c, trendPipeline(userID, since, query.Window, "$topic", "$performance_percentage")); err != nil {
+		return domain.ProgressReport{}, err
+	}
+	if report.Roles, err = r.trends(c, trendPipeline(userID, since, query.Window, "$role", "$performance_percentage")); err != nil {
+		return domain.ProgressReport{}, err
+	}
+
+	// Criterion scores are a map on the room; unwind it into one document per criterion
+	criteriaStages := []bson.D{
+		{{Key: "$project", Value: bson.M{
+			"finished_at": 1,
+			"criteria":    bson.M{"$objectToArray": bson.M{"$ifNull": bson.A{"$criterion_scores", bson.M{}}}},
+		}}},
+		{{Key: "$unwind", Value: "$criteria"}},
+	}
+	if report.Criteria, err = r.trends(c, trendPipeline(userID, since, query.Window, "$criteria.k", "$criteria.v", criteriaStages...)); err != nil {
+		return domain.ProgressReport{}, err
+	}
+
+	report.BestAreas, report.WorstAreas = rankProgressAreas(report.Topics, report.Criteria)
+	return report, nil
+}
+
+// rankProgressAreas picks the topics and rubric criteria with the highest and lowest
+// average scores. An area is never both among the best and the worst.
+func rankProgressAreas(topics, criteria []domain.ProgressSeries) ([]domain.ProgressArea, []domain.ProgressArea) {
+	var areas []domain.ProgressArea
+	for _, series := range topics {
+		areas = append(areas, domain.ProgressArea{Dimension: domain.ProgressDimensionTopic, Key: series.Key, AverageScore: series.AverageScore, Count: series.Count})
+	}
+	for _, series := range criteria {
+		areas = append(areas, domain.ProgressArea{Dimension: domain.ProgressDimensionCriterion, Key: series.Key, AverageScore: series.AverageScore, Count: series.Count})
+	}
+	sort.SliceStable(areas, func(i, j int) bool {
+		return areas[i].AverageScore > areas[j].AverageScore
+	})
+
+	best := min(progressAreaCount, (len(areas)+1)/2)
+	worst := min(progressAreaCount, len(areas)-best)
+
+	worstAreas := make([]domain.ProgressArea, 0, worst)
+	for i := len(areas) - 1; i >= len(areas)-worst; i-- {
+		worstAreas = append(worstAreas, areas[i])
+	}
+	return append([]domain.ProgressArea{}, areas[:best]...), worstAreas
+}
+
+func roundScore(score float64) float64 {
+	return math.Round(score*10) / 10
+}
@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type questionBankRepository struct {
+	database        mongo.Database
+	collection      string
+	skillRepository domain.SkillRepository
+}
+
+func NewQuestionBankRepository(database mongo.Database, collection string, skillRepository domain.SkillRepository) domain.QuestionBankRepository {
+	return &questionBankRepository{
+		database:        database,
+		collection:      collection,
+		skillRepository: skillRepository,
+	}
+}
+
+// tagQuestion tags a question with skills from its text, topic and tags unless the admin chose them
+func tagQuestion(skills []domain.Skill, question *domain.Question) {
+	if len(question.Skills) > 0 {
+		return
+	}
+	question.Skills = infrastructure.MatchSkills(skills, question.Text+" "+question.Topic+" "+strings.Join(question.Tags, " "))
+	if question.Skills == nil {
+		question.Skills = []string{}
+	}
+}
+
+// validateQuestion checks the required fields and normalises tags and difficulty
+func validateQuestion(question *domain.Question) error {
+	question.Text = strings.TrimSpace(question.Text)
+	if question.Text == "" {
+		return fmt.Errorf("question text is required")
+	}
+
+	question.Difficulty = strings.ToLower(strings.TrimSpace(question.Difficulty))
+	switch question.Difficulty {
+	case "":
+		question.Difficulty = domain.DifficultyMedium
+	case domain.DifficultyEasy, domain.DifficultyMedium, domain.DifficultyHard:
+	default:
+		return fmt.Errorf("invalid difficulty %q", question.Difficulty)
+	}
+
+	tags := []string{}
+	for _, tag := range question.Tags {
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	question.Tags = tags
+	if question.Rubric == nil {
+		question.Rubric = []string{}
+	}
+	return nil
+}
+
+// questionFilter converts a domain.QuestionFilter into a Mongo query
+func questionFilter(filter domain.QuestionFilter) bson.M {
+	query := bson.M{}
+	if filter.Role != "" {
+		query["role"] = bson.M{"$regex": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(filter.Role) + "$", Options: "i"}}
+	}
+	if filter.Topic != "" {
+		query["topic"] = bson.M{"$regex": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(filter.Topic) + "$", Options: "i"}}
+	}
+	if filter.Difficulty != "" {
+		query["difficulty"] = strings.ToLower(filter.Difficulty)
+	}
+	if len(filter.Tags) > 0 {
+		tags := make([]string, 0, len(filter.Tags))
+		for _, tag := range filter.Tags {
+			tags = append(tags, strings.ToLower(tag))
+		}
+		query["tags"] = bson.M{"$all": tags}
+	}
+	return query
+}
+
+// CreateQuestion implements domain.QuestionBankRepository.
+func (q *questionBankRepository) CreateQuestion(c context.Context, question domain.Question) (domain.Question, error) {
+	if err := validateQuestion(&question); err != nil {
+		return domain.Question{}, err
+	}
+	skills, err := q.skillRepository.GetSkills(c)
+	if err != nil {
+		return domain.Question{}, err
+	}
+	tagQuestion(skills, &question)
+
+	question.ID = primitive.NewObjectID()
+	question.CreatedAt = time.Now().Unix()
+	question.UpdatedAt = question.CreatedAt
+
+	collection := q.database.Collection(q.collection)
+	_, err = collection.InsertOne(c, question)
+	if err != nil {
+		return domain.Question{}, fmt.Errorf("failed to create question: %v", err)
+	}
+	return question, nil
+}
+
+// GetQuestion implements domain.QuestionBankRepository.
+func (q *questionBankRepository) GetQuestion(c context.Context, questionID string) (domain.Question, error) {
+	objectID, err := primitive.ObjectIDFromHex(questionID)
+	if err != nil {
+		return domain.Question{}, fmt.Errorf("invalid question ID format: %v", err)
+	}
+
+	collection := q.database.Collection(q.collection)
+	var question domain.Question
+	err = collection.FindOne(c, bson.M{"_id": objectID}).Decode(&question)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.Question{}, fmt.Errorf("question not found")
+		}
+		return domain.Question{}, err
+	}
+	return question, nil
+}
+
+// GetQuestions implements domain.QuestionBankRepository.
+func (q *questionBankRepository) GetQuestions(c context.Context, filter domain.QuestionFilter) ([]domain.Question, error) {
+	collection := q.database.Collection(q.collection)
+	cursor, err := collection.Find(c, questionFilter(filter))
+	if err != nil {
+		return nil, err
+	}
+
+	var questions []domain.Question
+	if err := cursor.All(c, &questions); err != nil {
+		return nil, err
+	}
+	return questions, nil
+}
+
+// UpdateQuestion implements domain.QuestionBankRepository.
+func (q *questionBankRepository) UpdateQuestion(c context.Context, questionID string, question domain.Question) (domain.Question, error) {
+	existing, err := q.GetQuestion(c, questionID)
+	if err != nil {
+		return domain.Question{}, err
+	}
+	if err := validateQuestion(&question); err != nil {
+		return domain.Question{}, err
+	}
+	skills, err := q.skillRepository.GetSkills(c)
+	if err != nil {
+		return domain.Question{}, err
+	}
+	tagQuestion(skills, &question)
+
+	question.ID = existing.ID
+	question.CreatedAt = existing.CreatedAt
+	question.UpdatedAt = time.Now().Unix()
+
+	collection := q.database.Collection(q.collection)
+	_, err = collection.UpdateOne(c, bson.M{"_id": existing.ID}, bson.M{"$set": question})
+	if err != nil {
+		return domain.Question{}, err
+	}
+	return question, nil
+}
+
+// DeleteQuestion implements domain.QuestionBankRepository.
+func (q *questionBankRepository) DeleteQuestion(c context.Context, questionID string) error {
+	objectID, err := primitive.ObjectIDFromHex(questionID)
+	if err != nil {
+		return fmt.Errorf("invalid question ID format: %v", err)
+	}
+
+	collection := q.database.Collection(q.collection)
+	result, err := collection.DeleteOne(c, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("question not found")
+	}
+	return nil
+}
+
+// ImportQuestions implements domain.QuestionBankRepository.
+// Every question is validated before anything is inserted so a bad row rejects the whole import.
+func (q *questionBankRepository) ImportQuestions(c context.Context, questions []domain.Question) (int, error) {
+	if len(questions) == 0 {
+		return 0, fmt.Errorf("no questions to import")
+	}
+
+	skills, err := q.skillRepository.GetSkills(c)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().Unix()
+	documents := make([]interface{}, 0, len(questions))
+	for i := range questions {
+		if err := validateQuestion(&questions[i]); err != nil {
+			return 0, fmt.Errorf("question %d: %v", i+1, err)
+		}
+		tagQuestion(skills, &questions[i])
+		questions[i].ID = primitive.NewObjectID()
+		questions[i].CreatedAt = now
+		questions[i].UpdatedAt = now
+		documents = append(documents, questions[i])
+	}
+
+	collection := q.database.Collection(q.collection)
+	result, err := collection.InsertMany(c, documents)
+	if err != nil {
+		return 0, fmt.Errorf("failed to import questions: %v", err)
+	}
+	return len(result.InsertedIDs), nil
+}
+
+// SampleQuestions implements domain.QuestionBankRepository.
+func (q *questionBankRepository) SampleQuestions(c context.Context, filter domain.QuestionFilter, count int) ([]domain.Question, error) {
+	collection := q.database.Collection(q.collection)
+	cursor, err := collection.Aggregate(c, mongo.Pipeline{
+		{{Key: "$match", Value: questionFilter(filter)}},
+		{{Key: "$sample", Value: bson.M{"size": count}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var questions []domain.Question
+	if err := cursor.All(c, &questions); err != nil {
+		return nil, err
+	}
+	return questions, nil
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Defaults and limits of recommendations and practice plans
+const (
+	defaultRecommendationCount = 3
+	maxRecommendationCount     = 10
+	defaultPlanWeeks           = 4
+	maxPlanWeeks               = 12
+	defaultPlanRoomsPerWeek    = 3
+	maxPlanRoomsPerWeek        = 7
+)
+
+type recommendationRepository struct {
+	database       mongo.Database
+	collection     string
+	roomRepository domain.RoomRepository
+}
+
+func NewRecommendationRepository(database mongo.Database, collection string, roomRepository domain.RoomRepository) domain.RecommendationRepository {
+	return &recommendationRepository{
+		database:       database,
+		collection:     collection,
+		roomRepository: roomRepository,
+	}
+}
+
+// practiceProfile loads the user's rooms and ranks what they should practice
+func (r *recommendationRepository) practiceProfile(c context.Context, userID primitive.ObjectID) (infrastructure.PracticeProfile, error) {
+	rooms, err := r.roomRepository.GetRoomsWithUserID(c, userID)
+	if err != nil {
+		return infrastructure.PracticeProfile{}, fmt.Errorf("failed to get user rooms: %v", err)
+	}
+
+	profile := infrastructure.BuildPracticeProfile(rooms)
+	if len(profile.Topics) == 0 {
+		return infrastructure.PracticeProfile{}, fmt.Errorf("complete an interview to get recommendations")
+	}
+	return profile, nil
+}
+
+// GetRecommendations implements domain.RecommendationRepository.
+func (r *recommendationRepository) GetRecommendations(c context.Context, userID primitive.ObjectID, limit int) ([]domain.Recommendation, error) {
+	if limit <= 0 {
+		limit = defaultRecommendationCount
+	}
+	if limit > maxRecommendationCount {
+		limit = maxRecommendationCount
+	}
+
+	profile, err := r.practiceProfile(c, userID)
+	if err != nil {
+		return nil, err
+	}
+	return infrastructure.Recommend(profile, limit, 0, 0), nil
+}
+
+// CreatePracticePlan implements domain.RecommendationRepository.
+// Each week moves on to the next weak areas and, every second week, raises the difficulty.
+func (r *recommendationRepository) CreatePracticePlan(c context.Context, userID primitive.ObjectID, request domain.PracticePlanRequest) (domain.PracticePlan, error) {
+	if request.Weeks <= 0 {
+		request.Weeks = defaultPlanWeeks
+	}
+	if request.Weeks > maxPlanWeeks {
+		request.Weeks = maxPlanWeeks
+	}
+	if request.RoomsPerWeek <= 0 {
+		request.RoomsPerWeek = defaultPlanRoomsPerWeek
+	}
+	if request.RoomsPerWeek > maxPlanRoomsPerWeek {
+		request.RoomsPerWeek = maxPlanRoomsPerWeek
+	}
+
+	profile, err := r.practiceProfile(c, userID)
+	if err != nil {
+		return domain.PracticePlan{}, err
+	}
+
+	plan := domain.PracticePlan{
+		UserID:       userID,
+		WeakTopics:   []string{},
+		WeakCriteria: []string{},
+		CreatedAt:    time.Now().Unix(),
+	}
+	for _, topic := range profile.Topics {
+		plan.WeakTopics = append(plan.WeakTopics, topic.Topic)
+	}
+	for _, criterion := range profile.Criteria {
+		plan.WeakCriteria = append(plan.WeakCriteria, criterion.Criterion)
+	}
+
+	for week := 0; week < request.Weeks; week++ {
+		recommendations := infrastructure.Recommend(profile, request.RoomsPerWeek, week*request.RoomsPerWeek, week/2)
+		for i := range recommendations {
+			recommendations[i].ID = primitive.NewObjectID()
+		}
+		plan.Weeks = append(plan.Weeks, domain.PracticeWeek{
+			Week:            week + 1,
+			Goal:            infrastructure.PracticeWeekGoal(recommendations),
+			Recommendations: recommendations,
+		})
+	}
+
+	// The plan has no _id, so a plan the user already has keeps its own; read it back
+	collection := r.database.Collection(r.collection)
+	var saved domain.PracticePlan
+	err = collection.FindOneAndReplace(
+		c,
+		bson.M{"user_id": userID},
+		plan,
+		options.FindOneAndReplace().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&saved)
+	if err != nil {
+		return domain.PracticePlan{}, fmt.Errorf("failed to save practice plan: %v", err)
+	}
+	return saved, nil
+}
+
+// GetPracticePlan implements domain.RecommendationRepository.
+func (r *recommendationRepository) GetPracticePlan(c context.Context, userID primitive.ObjectID) (domain.PracticePlan, error) {
+	collection := r.database.Collection(r.collection)
+	var plan domain.PracticePlan
+	err := collection.FindOne(c, bson.M{"user_id": userID}).Decode(&plan)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.PracticePlan{}, fmt.Errorf("practice plan not found")
+		}
+		return domain.PracticePlan{}, err
+	}
+	return plan, nil
+}
+
+// StartRecommendation implements domain.RecommendationRepository.
+// A recommendation with an ID is looked up in the user's practice plan and marked as
+// started; any other recommendation is created as given.
+func (r *recommendationRepository) StartRecommendation(c context.Context, userID primitive.ObjectID, recommendation domain.Recommendation) (domain.Room, error) {
+	var plan domain.PracticePlan
+	week, index := -1, -1
+	if !recommendation.ID.IsZero() {
+		var err error
+		plan, err = r.GetPracticePlan(c, userID)
+		if err != nil {
+			return domain.Room{}, fmt.Errorf("recommendation not found")
+		}
+		for w := range plan.Weeks {
+			for i := range plan.Weeks[w].Recommendations {
+				if plan.Weeks[w].Recommendations[i].ID == recommendation.ID {
+					week, index = w, i
+				}
+			}
+		}
+		if week == -1 {
+			return domain.Room{}, fmt.Errorf("recommendation not found")
+		}
+		recommendation = plan.Weeks[week].Recommendations[index]
+		if !recommendation.RoomID.IsZero() {
+			return domain.Room{}, fmt.Errorf("recommendation was already started")
+		}
+	}
+
+	if recommendation.Role == "" || recommendation.Topic == "" {
+		return domain.Room{}, fmt.Errorf("role and topic are required")
+	}
+
+	roomID := primitive.NewObjectID()
+	collection := r.database.Collection(r.collection)
+	var field string
+	if week != -1 {
+		field = fmt.Sprintf("weeks.%d.recommendations.%d.room_id", week, index)
+		// Claim the recommendation before the room is created, so it is only started once
+		result, err := collection.UpdateOne(
+			c,
+			bson.M{"_id": plan.ID, field: bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{field: roomID}},
+		)
+		if err != nil {
+			return domain.Room{}, fmt.Errorf("failed to update practice plan: %v", err)
+		}
+		if result.ModifiedCount == 0 {
+			return domain.Room{}, fmt.Errorf("recommendation was already started")
+		}
+	}
+
+	room, err := r.roomRepository.CreateRoom(c, domain.Room{
+		ID:            roomID,
+		UserID:        userID,
+		Role:          recommendation.Role,
+		Topic:         recommendation.Topic,
+		InterviewType: recommendation.InterviewType,
+		Difficulty:    recommendation.Difficulty,
+		Focus:         recommendation.Focus,
+	})
+	if err != nil {
+		if week != -1 {
+			// Release the claim so the recommendation can be started again
+			if _, releaseErr := collection.UpdateOne(c, bson.M{"_id": plan.ID, field: roomID}, bson.M{"$unset": bson.M{field: ""}}); releaseErr != nil {
+				log.Printf("failed to release recommendation of practice plan %s: %v", plan.ID.Hex(), releaseErr)
+			}
+		}
+		return domain.Room{}, err
+	}
+	return room, nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type resumeRepository struct {
+	database         mongo.Database
+	collection       string
+	blobStore        domain.BlobStore
+	geminiRepository domain.GeminiRepository
+}
+
+func NewResumeRepository(database mongo.Database, collection string, blobStore domain.BlobStore, geminiRepository domain.GeminiRepository) domain.ResumeRepository {
+	return &resumeRepository{
+		database:         database,
+		collection:       collection,
+		blobStore:        blobStore,
+		geminiRepository: geminiRepository,
+	}
+}
+
+// UploadResume implements domain.ResumeRepository.
+// The text is extracted locally and only the text is sent to Gemini.
+func (r *resumeRepository) UploadResume(c context.Context, userID primitive.ObjectID, upload domain.ResumeUpload) (domain.CandidateProfile, error) {
+	if userID.IsZero() {
+		return domain.CandidateProfile{}, fmt.Errorf("invalid user ID")
+	}
+
+	text, err := infrastructure.ExtractDocumentText(upload.Filename, upload.Content)
+	if err != nil {
+		return domain.CandidateProfile{}, err
+	}
+
+	key := fmt.Sprintf("resumes/%s/%d%s", userID.Hex(), time.Now().Unix(), strings.ToLower(filepath.Ext(upload.Filename)))
+	resumeURL, err := r.blobStore.Put(c, key, upload.ContentType, bytes.NewReader(upload.Content))
+	if err != nil {
+		return domain.CandidateProfile{}, fmt.Errorf("failed to store resume: %v", err)
+	}
+
+	prompt := infrastructure.BuildCandidateProfilePrompt(text)
+	response, err := r.geminiRepository.GenerateResponse(infrastructure.BuildGeminiRequest(prompt))
+	if err != nil {
+		return domain.CandidateProfile{}, fmt.Errorf("failed to build candidate profile: %v", err)
+	}
+
+	profile, err := infrastructure.ParseCandidateProfile(response)
+	if err != nil {
+		return domain.CandidateProfile{}, err
+	}
+	profile.ResumeURL = resumeURL
+	profile.UpdatedAt = time.Now().Unix()
+
+	collection := r.database.Collection(r.collection)
+	result, err := collection.UpdateOne(c, bson.M{"_id": userID}, bson.M{"$set": bson.M{"candidate_profile": profile}})
+	if err != nil {
+		return domain.CandidateProfile{}, fmt.Errorf("failed to save candidate profile: %v", err)
+	}
+	if result.MatchedCount == 0 {
+		return domain.CandidateProfile{}, fmt.Errorf("user not found")
+	}
+
+	return profile, nil
+}
+
+// GetCandidateProfile implements domain.ResumeRepository.
+func (r *resumeRepository) GetCandidateProfile(c context.Context, userID primitive.ObjectID) (domain.CandidateProfile, error) {
+	collection := r.database.Collection(r.collection)
+	var user domain.User
+	err := collection.FindOne(c, bson.M{"_id": userID}).Decode(&user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.CandidateProfile{}, fmt.Errorf("user not found")
+		}
+		return domain.CandidateProfile{}, err
+	}
+
+	if user.CandidateProfile == nil {
+		return domain.CandidateProfile{}, fmt.Errorf("candidate profile not found")
+	}
+	return *user.CandidateProfile, nil
+}
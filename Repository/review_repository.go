@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Defaults and limits of review sessions
+const (
+	defaultReviewSessionSize = 5
+	maxReviewSessionSize     = 10
+)
+
+type reviewRepository struct {
+	database       mongo.Database
+	collection     string
+	roomRepository domain.RoomRepository
+}
+
+func NewReviewRepository(database mongo.Database, collection string, roomRepository domain.RoomRepository) domain.ReviewRepository {
+	return &reviewRepository{
+		database:       database,
+		collection:     collection,
+		roomRepository: roomRepository,
+	}
+}
+
+// refreshReviewItems brings the user's schedule up to date with their rooms: completed
+// review rooms reschedule the items they asked, and poorly answered questions of other
+// completed rooms become new items.
+func (r *reviewRepository) refreshReviewItems(c context.Context, userID primitive.ObjectID) error {
+	rooms, err := r.roomRepository.GetRoomsWithUserID(c, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user rooms: %v", err)
+	}
+	if err := r.applyReviewSessions(c, userID, rooms); err != nil {
+		return err
+	}
+	return r.addReviewItems(c, userID, rooms)
+}
+
+// applyReviewSessions reschedules items from the feedback of their completed review room
+func (r *reviewRepository) applyReviewSessions(c context.Context, userID primitive.ObjectID, rooms []domain.Room) error {
+	collection := r.database.Collection(r.collection)
+	cursor, err := collection.Find(c, bson.M{"user_id": userID, "session_room_id": bson.M{"$exists": true}})
+	if err != nil {
+		return err
+	}
+	var items []domain.ReviewItem
+	if err := cursor.All(c, &items); err != nil {
+		return err
+	}
+
+	roomsByID := make(map[primitive.ObjectID]domain.Room, len(rooms))
+	for _, room := range rooms {
+		roomsByID[room.ID] = room
+	}
+
+	now := time.Now()
+	for _, item := range items {
+		room, ok := roomsByID[item.SessionRoomID]
+		if ok && room.Status != "completed" {
+			continue
+		}
+
+		// Feedback of a review room refers to the items through the planned question IDs
+		if ok {
+			for _, feedback := range room.Feedback {
+				if feedback.QuestionID == item.ID {
+					score, _ := infrastructure.AuthoritativeScore(feedback)
+					infrastructure.ScheduleReview(&item, score, now)
+					break
+				}
+			}
+		}
+		item.SessionRoomID = primitive.NilObjectID
+
+		_, err := collection.ReplaceOne(c, bson.M{"_id": item.ID}, item)
+		if err != nil {
+			return fmt.Errorf("failed to update review item: %v", err)
+		}
+	}
+	return nil
+}
+
+// addReviewItems schedules every poorly answered question that has no item yet
+func (r *reviewRepository) addReviewItems(c context.Context, userID primitive.ObjectID, rooms []domain.Room) error {
+	collection := r.database.Collection(r.collection)
+	cursor, err := collection.Find(c, bson.M{"user_id": userID}, options.Find().SetProjection(bson.M{"message_id": 1}))
+	if err != nil {
+		return err
+	}
+	var existing []domain.ReviewItem
+	if err := cursor.All(c, &existing); err != nil {
+		return err
+	}
+	scheduled := make(map[primitive.ObjectID]bool, len(existing))
+	for _, item := range existing {
+		scheduled[item.MessageID] = true
+	}
+
+	now := time.Now()
+	var items []interface{}
+	for _, room := range rooms {
+		if room.Status != "completed" || room.Mode == domain.RoomModeReview {
+			continue
+		}
+		for _, feedback := range room.Feedback {
+			score, _ := infrastructure.AuthoritativeScore(feedback)
+			if score >= infrastructure.ReviewScoreThreshold || feedback.MessageID.IsZero() || scheduled[feedback.MessageID] {
+				continue
+			}
+			item := infrastructure.NewReviewItem(room, feedback, now)
+			item.ID = primitive.NewObjectID()
+			items = append(items, item)
+			scheduled[feedback.MessageID] = true
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	_, err = collection.InsertMany(c, items)
+	if err != nil {
+		return fmt.Errorf("failed to create review items: %v", err)
+	}
+	return nil
+}
+
+// GetReviewItems implements domain.ReviewRepository.
+// Items are returned soonest due first.
+func (r *reviewRepository) GetReviewItems(c context.Context, userID primitive.ObjectID, dueOnly bool) ([]domain.ReviewItem, error) {
+	if err := r.refreshReviewItems(c, userID); err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"user_id": userID}
+	if dueOnly {
+		filter["due_at"] = bson.M{"$lte": time.Now().Unix()}
+	}
+
+	collection := r.database.Collection(r.collection)
+	cursor, err := collection.Find(c, filter, options.Find().SetSort(bson.D{{Key: "due_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	items := []domain.ReviewItem{}
+	if err := cursor.All(c, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// CreateReviewSession implements domain.ReviewRepository.
+// The room re-asks the items that have been due the longest. Items still waiting in an
+// unfinished review room move to the new one.
+func (r *reviewRepository) CreateReviewSession(c context.Context, userID primitive.ObjectID, count int) (domain.Room, error) {
+	if count <= 0 {
+		count = defaultReviewSessionSize
+	}
+	if count > maxReviewSessionSize {
+		count = maxReviewSessionSize
+	}
+
+	items, err := r.GetReviewItems(c, userID, true)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	if len(items) == 0 {
+		return domain.Room{}, fmt.Errorf("no questions are due for review")
+	}
+	if len(items) > count {
+		items = items[:count]
+	}
+
+	room := domain.Room{
+		UserID:        userID,
+		Role:          items[0].Role,
+		InterviewType: domain.InterviewTypeGeneral,
+		Mode:          domain.RoomModeReview,
+	}
+	var topics []string
+	seen := make(map[string]bool)
+	itemIDs := make([]primitive.ObjectID, 0, len(items))
+	for _, item := range items {
+		room.PlannedQuestions = append(room.PlannedQuestions, infrastructure.ReviewPlannedQuestion(item))
+		if !seen[item.Topic] {
+			seen[item.Topic] = true
+			topics = append(topics, item.Topic)
+		}
+		itemIDs = append(itemIDs, item.ID)
+	}
+	room.Topic = strings.Join(topics, ", ")
+
+	room, err = r.roomRepository.CreateRoom(c, room)
+	if err != nil {
+		return domain.Room{}, err
+	}
+
+	collection := r.database.Collection(r.collection)
+	_, err = collection.UpdateMany(c, bson.M{"_id": bson.M{"$in": itemIDs}}, bson.M{"$set": bson.M{"session_room_id": room.ID}})
+	if err != nil {
+		return domain.Room{}, fmt.Errorf("failed to update review items: %v", err)
+	}
+	return room, nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	domain "github.com/chachidani/interview-coach-backend/Domain"
@@ -11,30 +14,48 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// bankQuestionsPerRoom is how many bank questions are planned for a question bank room
+const bankQuestionsPerRoom = 5
+
+// roomStatusGrading marks a room whose completion is being graded; it cannot be changed meanwhile
+const roomStatusGrading = "grading"
+
 type roomRepository struct {
-	database         mongo.Database
-	collection       string
-	geminiRepository domain.GeminiRepository
+	database                    mongo.Database
+	collection                  string
+	geminiRepository            domain.GeminiRepository
+	interviewTemplateRepository domain.InterviewTemplateRepository
+	questionBankRepository      domain.QuestionBankRepository
+	personaRepository           domain.PersonaRepository
+	messageAudioRepository      domain.MessageAudioRepository
+	skillRepository             domain.SkillRepository
+	goalRepository              domain.GoalRepository
 }
 
 // DeleteRoom implements domain.RoomRepository.
-func (r *roomRepository) DeleteRoom(c context.Context, roomID string) error {
+func (r *roomRepository) DeleteRoom(c context.Context, userID primitive.ObjectID, roomID string) error {
 	objectID, err := primitive.ObjectIDFromHex(roomID)
 	if err != nil {
 		return fmt.Errorf("invalid room ID format: %v", err)
 	}
 
 	collection := r.database.Collection(r.collection)
-	_, err = collection.DeleteOne(c, bson.M{"_id": objectID})
+	result, err := collection.DeleteOne(c, bson.M{"_id": objectID, "user_id": userID})
 	if err != nil {
 		return err
 	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("room not found")
+	}
 	return nil
 }
 
 // GetRoom implements domain.RoomRepository.
+// It finds any user's room, so it is only for callers that authorise access
+// themselves, like share links; everything else goes through GetUserRoom.
 func (r *roomRepository) GetRoom(c context.Context, roomID string) (domain.Room, error) {
 	objectID, err := primitive.ObjectIDFromHex(roomID)
 	if err != nil {
@@ -68,56 +89,363 @@ func (r *roomRepository) GetRoomsWithUserID(c context.Context, userID primitive.
 }
 
 // UpdateRoom implements domain.RoomRepository.
-func (r *roomRepository) UpdateRoom(c context.Context, roomID string, room domain.Room) (domain.Room, error) {
+func (r *roomRepository) UpdateRoom(c context.Context, userID primitive.ObjectID, roomID string, request domain.UpdateRoomRequest) (domain.Room, error) {
 	objectID, err := primitive.ObjectIDFromHex(roomID)
 	if err != nil {
 		return domain.Room{}, fmt.Errorf("invalid room ID format: %v", err)
 	}
 
+	fields := bson.M{}
+	if request.Role != nil {
+		fields["role"] = *request.Role
+	}
+	if request.Topic != nil {
+		fields["topic"] = *request.Topic
+	}
+	if request.Difficulty != nil {
+		fields["difficulty"] = *request.Difficulty
+	}
+	if request.Focus != nil {
+		fields["focus"] = request.Focus
+	}
+	if request.AutoSpeech != nil {
+		fields["auto_speech"] = *request.AutoSpeech
+	}
+	if len(fields) == 0 {
+		return r.ownedRoom(c, userID, roomID)
+	}
+
 	collection := r.database.Collection(r.collection)
-	_, err = collection.UpdateOne(c, bson.M{"_id": objectID}, bson.M{"$set": room})
+	var room domain.Room
+	err = collection.FindOneAndUpdate(
+		c,
+		bson.M{"_id": objectID, "user_id": userID},
+		bson.M{"$set": fields},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&room)
 	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.Room{}, fmt.Errorf("room not found")
+		}
 		return domain.Room{}, err
 	}
+
+	// The room's skills come from its role and topic
+	if request.Role != nil || request.Topic != nil {
+		skills, err := r.skillRepository.TagText(c, room.Role+" "+room.Topic, true)
+		if err != nil {
+			log.Printf("failed to tag room with skills: %v", err)
+			return room, nil
+		}
+		room.Skills = skills
+		if _, err := collection.UpdateOne(c, bson.M{"_id": room.ID}, bson.M{"$set": bson.M{"skills": room.Skills}}); err != nil {
+			log.Printf("failed to save skills of room %s: %v", room.ID.Hex(), err)
+		}
+	}
 	return room, nil
 }
 
-func NewRoomRepository(database mongo.Database, collection string, geminiRepository domain.GeminiRepository) domain.RoomRepository {
+func NewRoomRepository(database mongo.Database, collection string, geminiRepository domain.GeminiRepository, interviewTemplateRepository domain.InterviewTemplateRepository, questionBankRepository domain.QuestionBankRepository, personaRepository domain.PersonaRepository, messageAudioRepository domain.MessageAudioRepository, skillRepository domain.SkillRepository, goalRepository domain.GoalRepository) domain.RoomRepository {
 	return &roomRepository{
-		database:         database,
-		collection:       collection,
-		geminiRepository: geminiRepository,
+		database:                    database,
+		collection:                  collection,
+		geminiRepository:            geminiRepository,
+		interviewTemplateRepository: interviewTemplateRepository,
+		questionBankRepository:      questionBankRepository,
+		personaRepository:           personaRepository,
+		messageAudioRepository:      messageAudioRepository,
+		skillRepository:             skillRepository,
+		goalRepository:              goalRepository,
+	}
+}
+
+// speak attaches audio to an interviewer message when the room asks for it.
+// Speech is a convenience, so failures are logged instead of failing the turn.
+func (r *roomRepository) speak(c context.Context, room domain.Room, message *domain.Message) {
+	if !room.AutoSpeech {
+		return
+	}
+
+	audioURL, err := r.messageAudioRepository.SpeakMessage(c, room.UserID, *message)
+	if err != nil {
+		log.Printf("failed to synthesize message %s: %v", message.ID.Hex(), err)
+		return
+	}
+	message.AudioURL = audioURL
+}
+
+// roomTemplate returns the interview template version the room was created with
+func (r *roomRepository) roomTemplate(c context.Context, room domain.Room) (domain.InterviewTemplate, error) {
+	interviewType := room.InterviewType
+	if interviewType == "" {
+		interviewType = domain.InterviewTypeGeneral
+	}
+	if room.TemplateVersion == 0 {
+		return r.interviewTemplateRepository.GetLatestTemplate(c, interviewType)
+	}
+	return r.interviewTemplateRepository.GetTemplateVersion(c, interviewType, room.TemplateVersion)
+}
+
+// roomInterviewer resolves who speaks the room's next interviewer turn.
+// Panel personas take turns in the order they were configured.
+func (r *roomRepository) roomInterviewer(c context.Context, room domain.Room, template domain.InterviewTemplate) (infrastructure.Interviewer, error) {
+	interviewer := infrastructure.Interviewer{Template: template}
+	if len(room.Personas) == 0 {
+		return interviewer, nil
+	}
+
+	for _, key := range room.Personas {
+		persona, err := r.personaRepository.GetPersona(c, key)
+		if err != nil {
+			return infrastructure.Interviewer{}, fmt.Errorf("failed to load persona %s: %v", key, err)
+		}
+		interviewer.Panel = append(interviewer.Panel, persona)
+	}
+
+	interviewerTurns := 0
+	for _, msg := range infrastructure.InterviewMessages(room.Messages) {
+		if msg.Sender == "ai" {
+			interviewerTurns++
+		}
+	}
+	interviewer.Persona = &interviewer.Panel[interviewerTurns%len(interviewer.Panel)]
+	return interviewer, nil
+}
+
+// planBankQuestions picks the bank questions a question bank room will ask
+func (r *roomRepository) planBankQuestions(c context.Context, room domain.Room) ([]domain.PlannedQuestion, error) {
+	questions, err := r.questionBankRepository.SampleQuestions(c, domain.QuestionFilter{
+		Role:       room.Role,
+		Topic:      room.Topic,
+		Difficulty: room.Difficulty,
+	}, bankQuestionsPerRoom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bank questions: %v", err)
+	}
+	if len(questions) == 0 {
+		return nil, fmt.Errorf("no bank questions match this role and topic")
+	}
+
+	planned := make([]domain.PlannedQuestion, 0, len(questions))
+	for _, question := range questions {
+		planned = append(planned, domain.PlannedQuestion{
+			QuestionID:      question.ID,
+			Text:            question.Text,
+			ReferenceAnswer: question.ReferenceAnswer,
+			Rubric:          question.Rubric,
+			Skills:          question.Skills,
+		})
+	}
+	return planned, nil
+}
+
+// hasPlannedQuestions reports whether the interviewer follows room.PlannedQuestions
+func hasPlannedQuestions(room domain.Room) bool {
+	return room.Mode == domain.RoomModeQuestionBank || room.Mode == domain.RoomModeReview
+}
+
+// plannedQuestion returns the planned question with the given ID, or nil
+func plannedQuestion(room domain.Room, questionID primitive.ObjectID) *domain.PlannedQuestion {
+	if questionID.IsZero() {
+		return nil
+	}
+	for i := range room.PlannedQuestions {
+		if room.PlannedQuestions[i].QuestionID == questionID {
+			return &room.PlannedQuestions[i]
+		}
+	}
+	return nil
+}
+
+// nextInterviewerMessage asks Gemini for the interviewer's next turn.
+// In question bank and review rooms it may advance room.CurrentQuestion.
+func (r *roomRepository) nextInterviewerMessage(interviewer infrastructure.Interviewer, room *domain.Room) (domain.Message, error) {
+	// Format message history for prompt using the reusable builder
+	messageHistory := infrastructure.BuildMessageHistory(*room)
+
+	aiMessage := domain.Message{
+		ID:        primitive.NewObjectID(),
+		Sender:    "ai",
+		Timestamp: time.Now().Unix(),
+	}
+	if interviewer.Persona != nil {
+		aiMessage.Persona = interviewer.Persona.Key
+	}
+
+	if hasPlannedQuestions(*room) && room.CurrentQuestion >= len(room.PlannedQuestions) {
+		// The plan is over: wrap up instead of improvising questions outside the bank
+		prompt := infrastructure.BuildBankClosingPrompt(interviewer, *room, messageHistory)
+		aiResponse, err := r.geminiRepository.GenerateResponse(infrastructure.BuildGeminiRequest(prompt))
+		if err != nil {
+			return domain.Message{}, err
+		}
+		aiMessage.Text = aiResponse
+		aiMessage.Type = domain.MessageTypeClosing
+		return aiMessage, nil
+	}
+
+	if !hasPlannedQuestions(*room) {
+		// Create prompt for Gemini including context of the interview
+		prompt := infrastructure.BuildFollowUpPrompt(interviewer, *room, messageHistory)
+		aiResponse, err := r.geminiRepository.GenerateResponse(infrastructure.BuildGeminiRequest(prompt))
+		if err != nil {
+			return domain.Message{}, err
+		}
+
+		turn, err := infrastructure.ParseInterviewerTurn(aiResponse)
+		if err != nil {
+			// Keep an unstructured reply as it is; it is graded as a new question
+			aiMessage.Text = aiResponse
+			return aiMessage, nil
+		}
+		aiMessage.Text = turn.Text
+		switch turn.Action {
+		case infrastructure.InterviewerActionFollowUp:
+			aiMessage.Type = domain.MessageTypeFollowUp
+		case infrastructure.InterviewerActionClose:
+			aiMessage.Type = domain.MessageTypeClosing
+		default:
+			aiMessage.Type = domain.MessageTypeQuestion
+		}
+		return aiMessage, nil
+	}
+
+	current := room.PlannedQuestions[room.CurrentQuestion]
+	var next *domain.PlannedQuestion
+	if room.CurrentQuestion+1 < len(room.PlannedQuestions) {
+		next = &room.PlannedQuestions[room.CurrentQuestion+1]
+	}
+
+	prompt := infrastructure.BuildBankFollowUpPrompt(interviewer, *room, messageHistory, current, next)
+	aiResponse, err := r.geminiRepository.GenerateResponse(infrastructure.BuildGeminiRequest(prompt))
+	if err != nil {
+		return domain.Message{}, err
+	}
+
+	turn, err := infrastructure.ParseInterviewerTurn(aiResponse)
+	if err != nil {
+		// Treat an unstructured reply as a follow-up rather than losing it
+		turn = infrastructure.InterviewerTurn{Action: infrastructure.InterviewerActionFollowUp, Text: aiResponse}
+	}
+
+	aiMessage.Text = turn.Text
+	switch {
+	case turn.Action == infrastructure.InterviewerActionNextQuestion && next != nil:
+		room.CurrentQuestion++
+		aiMessage.Type = domain.MessageTypeQuestion
+		aiMessage.QuestionID = next.QuestionID
+	case turn.Action == infrastructure.InterviewerActionClose:
+		room.CurrentQuestion = len(room.PlannedQuestions)
+		aiMessage.Type = domain.MessageTypeClosing
+	default:
+		aiMessage.Type = domain.MessageTypeFollowUp
+		aiMessage.QuestionID = current.QuestionID
+	}
+	return aiMessage, nil
+}
+
+// extractJobProfile asks Gemini for the structured requirements of a job description
+func (r *roomRepository) extractJobProfile(jobDescription string) (domain.JobProfile, error) {
+	prompt := infrastructure.BuildJobProfilePrompt(jobDescription)
+	response, err := r.geminiRepository.GenerateResponse(infrastructure.BuildGeminiRequest(prompt))
+	if err != nil {
+		return domain.JobProfile{}, fmt.Errorf("failed to extract job profile: %v", err)
+	}
+	return infrastructure.ParseJobProfile(response)
+}
+
+// analyseSTAR asks Gemini for the STAR breakdown of a behavioral answer.
+// The analysis adds to the feedback, so failures are logged and leave it out.
+func (r *roomRepository) analyseSTAR(model, question, answer string) *domain.STARAnalysis {
+	request := infrastructure.BuildGeminiRequest(infrastructure.BuildSTARPrompt(question, answer))
+	request.Model = model
+	response, err := r.geminiRepository.GenerateResponse(request)
+	if err != nil {
+		log.Printf("failed to generate STAR analysis: %v", err)
+		return nil
+	}
+
+	analysis, err := infrastructure.ParseSTARAnalysis(response)
+	if err != nil {
+		log.Printf("failed to parse STAR analysis: %v", err)
+		return nil
 	}
+	return &analysis
 }
 
 // CreateRoom implements domain.RoomRepository.
-func (r *roomRepository) CreateRoom(c context.Context, room domain.Room) (string, error) {
+func (r *roomRepository) CreateRoom(c context.Context, room domain.Room) (domain.Room, error) {
+	// Select the latest template for the requested interview type
+	if room.InterviewType == "" {
+		room.InterviewType = domain.InterviewTypeGeneral
+	}
+	template, err := r.interviewTemplateRepository.GetLatestTemplate(c, room.InterviewType)
+	if err != nil {
+		return domain.Room{}, fmt.Errorf("failed to load interview template: %v", err)
+	}
+	room.TemplateVersion = template.Version
+
+	// Resolve the interviewer persona, or the whole panel, before anything is generated
+	interviewer, err := r.roomInterviewer(c, room, template)
+	if err != nil {
+		return domain.Room{}, err
+	}
+
+	// Tailor the room to the job posting the user pasted
+	if strings.TrimSpace(room.JobDescription) != "" {
+		profile, err := r.extractJobProfile(room.JobDescription)
+		if err != nil {
+			return domain.Room{}, err
+		}
+		room.JobProfile = &profile
+	}
+
+	// Personalise the room with the user's CV when one was uploaded
+	var user domain.User
+	err = r.database.Collection(domain.CollectionUser).FindOne(c, bson.M{"_id": room.UserID}).Decode(&user)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return domain.Room{}, fmt.Errorf("failed to load user: %v", err)
+	}
+	room.CandidateProfile = user.CandidateProfile
+
+	// Tag the room with skills; tags only feed analytics, so a failure does not stop the room
+	room.Skills, err = r.skillRepository.TagText(c, room.Role+" "+room.Topic, true)
+	if err != nil {
+		log.Printf("failed to tag room with skills: %v", err)
+	}
+
 	// Generate initial message using Gemini
-	prompt := fmt.Sprintf("You are an AI interviewer. The role is %s and the topic is %s. Please start the interview with a greeting and your first question.", room.Role, room.Topic)
-
-	geminiRequest := domain.GeminiRequest{
-		Contents: []struct {
-			Parts []struct {
-				Text string `json:"text"`
-			} `json:"parts"`
-		}{
-			{
-				Parts: []struct {
-					Text string `json:"text"`
-				}{
-					{Text: prompt},
-				},
-			},
-		},
+	prompt := infrastructure.BuildOpeningPrompt(interviewer, room)
+	switch room.Mode {
+	case domain.RoomModeQuestionBank:
+		room.PlannedQuestions, err = r.planBankQuestions(c, room)
+		if err != nil {
+			return domain.Room{}, err
+		}
+	case domain.RoomModeReview:
+		// The review scheduler plans the questions, see ReviewRepository.CreateReviewSession
+		if len(room.PlannedQuestions) == 0 {
+			return domain.Room{}, fmt.Errorf("review rooms need questions that are due for review")
+		}
+	default:
+		room.PlannedQuestions = nil
 	}
+	if hasPlannedQuestions(room) {
+		room.CurrentQuestion = 0
+		prompt = infrastructure.BuildBankOpeningPrompt(interviewer, room, room.PlannedQuestions[0])
+	}
+
+	geminiRequest := infrastructure.BuildGeminiRequest(prompt)
 
 	initialMessage, err := r.geminiRepository.GenerateResponse(geminiRequest)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate initial message: %v", err)
+		return domain.Room{}, fmt.Errorf("failed to generate initial message: %v", err)
 	}
 
-	// Ensure room has a valid ID
-	room.ID = primitive.NewObjectID()
+	// Ensure room has a valid ID; callers that claim something for the room choose it up front
+	if room.ID.IsZero() {
+		room.ID = primitive.NewObjectID()
+	}
 
 	// Add initial message to room
 	room.Messages = []domain.Message{
@@ -128,6 +456,14 @@ func (r *roomRepository) CreateRoom(c context.Context, room domain.Room) (string
 			Timestamp: time.Now().Unix(),
 		},
 	}
+	if interviewer.Persona != nil {
+		room.Messages[0].Persona = interviewer.Persona.Key
+	}
+	room.Messages[0].Type = domain.MessageTypeQuestion
+	if hasPlannedQuestions(room) {
+		room.Messages[0].QuestionID = room.PlannedQuestions[0].QuestionID
+	}
+	r.speak(c, room, &room.Messages[0])
 	room.CreatedAt = time.Now().Unix()
 	room.Status = "active"
 
@@ -135,7 +471,7 @@ func (r *roomRepository) CreateRoom(c context.Context, room domain.Room) (string
 	collection := r.database.Collection(r.collection)
 	_, err = collection.InsertOne(c, room)
 	if err != nil {
-		return "", fmt.Errorf("failed to create room: %v", err)
+		return domain.Room{}, fmt.Errorf("failed to create room: %v", err)
 	}
 
 	// Update user's rooms array with the room ID
@@ -148,218 +484,739 @@ func (r *roomRepository) CreateRoom(c context.Context, room domain.Room) (string
 	if err != nil {
 		// If updating user fails, we should delete the room to maintain consistency
 		_, _ = collection.DeleteOne(c, bson.M{"_id": room.ID})
-		return "", fmt.Errorf("failed to update user with room ID: %v", err)
+		return domain.Room{}, fmt.Errorf("failed to update user with room ID: %v", err)
 	}
 
-	return "Room created successfully", nil
+	return room, nil
 }
 
 // AddMessageToRoom implements domain.RoomRepository.
-func (r *roomRepository) AddMessageToRoom(c context.Context, roomID string, message domain.Message) (domain.Room, error) {
-	objectID, err := primitive.ObjectIDFromHex(roomID)
-	if err != nil {
-		return domain.Room{}, fmt.Errorf("invalid room ID format: %v", err)
-	}
-
+func (r *roomRepository) AddMessageToRoom(c context.Context, userID primitive.ObjectID, roomID string, message domain.Message) (domain.Room, error) {
 	// First get the current room
-	collection := r.database.Collection(r.collection)
-	var room domain.Room
-	err = collection.FindOne(c, bson.M{"_id": objectID}).Decode(&room)
+	room, err := r.editableRoom(c, userID, roomID)
 	if err != nil {
 		return domain.Room{}, err
 	}
+	collection := r.database.Collection(r.collection)
 
-	// Add user's message to the room
-	message.ID = primitive.NewObjectID() // Ensure message has an ID
-	room.Messages = append(room.Messages, message)
-
-	// Format message history for prompt using the reusable builder
-	messageHistory := infrastructure.BuildMessageHistory(room)
-
-	// Create prompt for Gemini including context of the interview
-	prompt := fmt.Sprintf(`You are an AI interviewer. The role is %s and the topic is %s.
-
-Previous conversation:
-%s
-
-Please provide a relevant follow-up question or response based on the conversation above.`,
-		room.Role,
-		room.Topic,
-		messageHistory)
-
-	geminiRequest := domain.GeminiRequest{
-		Contents: []struct {
-			Parts []struct {
-				Text string `json:"text"`
-			} `json:"parts"`
-		}{
-			{
-				Parts: []struct {
-					Text string `json:"text"`
-				}{
-					{Text: prompt},
-				},
-			},
-		},
+	// Add user's message to the room, keeping an ID the caller chose for it
+	if message.ID.IsZero() {
+		message.ID = primitive.NewObjectID()
 	}
+	room.Messages = append(room.Messages, message)
 
-	// Get response from Gemini
-	aiResponse, err := r.geminiRepository.GenerateResponse(geminiRequest)
-	if err != nil {
+	// Get response from Gemini and add it to the room
+	if err := r.appendInterviewerMessage(c, &room); err != nil {
 		return domain.Room{}, err
 	}
 
-	// Add AI's response to the room
-	aiMessage := domain.Message{
-		ID:        primitive.NewObjectID(),
-		Sender:    "ai",
-		Text:      aiResponse,
-		Timestamp: time.Now().Unix(),
-	}
-	room.Messages = append(room.Messages, aiMessage)
-
-	// Update room in database
-	_, err = collection.UpdateOne(
+	// Push only the new messages so answers stored meanwhile are kept
+	result, err := collection.UpdateOne(
 		c,
-		bson.M{"_id": objectID},
-		bson.M{"$set": bson.M{"messages": room.Messages}},
+		bson.M{"_id": room.ID, "user_id": userID, "status": bson.M{"$nin": bson.A{"completed", roomStatusGrading}}},
+		bson.M{
+			"$push": bson.M{"messages": bson.M{"$each": room.Messages[len(room.Messages)-2:]}},
+			"$set":  bson.M{"current_question": room.CurrentQuestion},
+		},
 	)
 	if err != nil {
 		return domain.Room{}, err
 	}
+	if result.MatchedCount == 0 {
+		return domain.Room{}, fmt.Errorf("room is already completed")
+	}
 
 	return room, nil
 }
 
-// CompletedRoom implements domain.RoomRepository.
-func (r *roomRepository) CompletedRoom(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, error) {
-	objectID, err := primitive.ObjectIDFromHex(roomID)
+// evaluateRoom grades a room with the given Gemini model and returns a new feedback run.
+// Nothing is stored; see saveFeedbackRun.
+func (r *roomRepository) evaluateRoom(c context.Context, room domain.Room, model string) (domain.FeedbackRun, []domain.Feedback, error) {
+	template, err := r.roomTemplate(c, room)
 	if err != nil {
-		return domain.Room{}, fmt.Errorf("invalid room ID format: %v", err)
+		return domain.FeedbackRun{}, nil, fmt.Errorf("failed to load interview template: %v", err)
 	}
 
-	collection := r.database.Collection(r.collection)
-	var room domain.Room
-	err = collection.FindOne(c, bson.M{"_id": objectID}).Decode(&room)
+	run := domain.FeedbackRun{
+		ID:              primitive.NewObjectID(),
+		RoomID:          room.ID,
+		UserID:          room.UserID,
+		Model:           model,
+		PromptVersion:   infrastructure.FeedbackPromptVersion,
+		TemplateVersion: template.Version,
+		CreatedAt:       time.Now().Unix(),
+	}
+	criteria := infrastructure.TemplateCriteria(template)
+	feedbacks := []domain.Feedback{}
+	skills, err := r.skillRepository.GetSkills(c)
 	if err != nil {
-		return domain.Room{}, err
+		return domain.FeedbackRun{}, nil, fmt.Errorf("failed to load skills: %v", err)
 	}
 
-	room.Status = "completed"
-
-	// Calculate performance percentage based on feedback
-	room.PerformancePercentage = 0
-	room.Feedback = []domain.Feedback{}
-
-	// Process messages in pairs (AI question + User answer)
-	for i := 0; i < len(room.Messages)-1; i += 2 {
-		if i+1 >= len(room.Messages) {
-			break
-		}
+	// Grade each main question together with its follow-ups and all of the candidate's replies
+	assistance := infrastructure.AssistanceByAnswer(room.Messages)
+	for _, unit := range infrastructure.SegmentConversation(room.Messages) {
+		question := unit.Question
+		answers := unit.Answers()
+		answer := unit.Exchange()
 
-		aiMessage := room.Messages[i]
-		userMessage := room.Messages[i+1]
-
-		// Skip if not a valid AI-User pair
-		if aiMessage.Sender != "ai" || userMessage.Sender != "user" {
-			continue
-		}
-
-		// Create feedback for this message pair
+		// Create feedback for this unit
 		feedback := domain.Feedback{
 			ID:              primitive.NewObjectID(),
-			UserID:          userID,
+			RunID:           run.ID,
+			UserID:          room.UserID,
 			RoomID:          room.ID,
-			MessageID:       userMessage.ID,
-			Question:        aiMessage.Text,
-			Answer:          userMessage.Text,
+			MessageID:       answers[0].ID,
+			QuestionID:      question.QuestionID,
+			Persona:         question.Persona,
+			Question:        question.Text,
+			Answer:          answer,
 			Strength:        []string{}, // Will be populated by AI
 			ToImprove:       []string{}, // Will be populated by AI
 			ScorePercentage: 0,          // Will be calculated by AI
-			CreatedAt:       time.Now().Unix(),
+			CreatedAt:       run.CreatedAt,
 		}
 
-		// Generate feedback using Gemini
-		prompt := fmt.Sprintf(`You are an AI interviewer providing feedback. The role is %s and the topic is %s.
-
-Question: %s
-Answer: %s
-
-Please provide:
-1. List of strengths in the answer
-2. Areas for improvement
-3. Score percentage (0-100)
-
-Format your response as JSON:
-{
-    "strength": ["strength1", "strength2"],
-    "to_improve": ["improvement1", "improvement2"],
-    "score_percentage": 85
-}`, room.Role, room.Topic, aiMessage.Text, userMessage.Text)
-
-		geminiRequest := domain.GeminiRequest{
-			Contents: []struct {
-				Parts []struct {
-					Text string `json:"text"`
-				} `json:"parts"`
-			}{
-				{
-					Parts: []struct {
-						Text string `json:"text"`
-					}{
-						{Text: prompt},
-					},
-				},
-			},
+		var used []string
+		var metrics []domain.DeliveryMetrics
+		for _, reply := range answers {
+			feedback.AnswerMessageIDs = append(feedback.AnswerMessageIDs, reply.ID)
+			used = append(used, assistance[reply.ID]...)
+			if reply.DeliveryMetrics != nil {
+				metrics = append(metrics, *reply.DeliveryMetrics)
+			}
+		}
+		feedback.DeliveryMetrics = infrastructure.AggregateDeliveryMetrics(metrics)
+
+		// Bank questions carry their own skills; other questions are tagged from their text
+		planned := plannedQuestion(room, question.QuestionID)
+		if planned != nil && len(planned.Skills) > 0 {
+			feedback.Skills = planned.Skills
+		} else {
+			feedback.Skills = infrastructure.AnswerSkills(skills, room, feedback)
 		}
 
+		// Generate feedback using Gemini
+		prompt := infrastructure.BuildFeedbackPrompt(template, room, question.Text, answer, planned)
+
+		geminiRequest := infrastructure.BuildGeminiRequest(prompt)
+		geminiRequest.Model = model
+
 		feedbackResponse, err := r.geminiRepository.GenerateResponse(geminiRequest)
 		if err != nil {
-			return domain.Room{}, fmt.Errorf("failed to generate feedback: %v", err)
+			return domain.FeedbackRun{}, nil, fmt.Errorf("failed to generate feedback: %v", err)
 		}
 
 		// Parse the JSON response
 		var feedbackData struct {
-			Strength        []string `json:"strength"`
-			ToImprove       []string `json:"to_improve"`
-			ScorePercentage int      `json:"score_percentage"`
+			Strength        []string                `json:"strength"`
+			ToImprove       []string                `json:"to_improve"`
+			ScorePercentage int                     `json:"score_percentage"`
+			Criteria        []domain.CriterionScore `json:"criteria"`
 		}
 
 		if err := json.Unmarshal([]byte(feedbackResponse), &feedbackData); err != nil {
-			return domain.Room{}, fmt.Errorf("failed to parse feedback response: %v", err)
+			return domain.FeedbackRun{}, nil, fmt.Errorf("failed to parse feedback response: %v", err)
 		}
 
+		// Keep any template-specific fields next to the common ones
+		var details map[string]interface{}
+		if err := json.Unmarshal([]byte(feedbackResponse), &details); err != nil {
+			return domain.FeedbackRun{}, nil, fmt.Errorf("failed to parse feedback response: %v", err)
+		}
+		delete(details, "strength")
+		delete(details, "to_improve")
+		delete(details, "score_percentage")
+		delete(details, "criteria")
+
 		// Update feedback with AI-generated data
 		feedback.Strength = feedbackData.Strength
 		feedback.ToImprove = feedbackData.ToImprove
 		feedback.ScorePercentage = feedbackData.ScorePercentage
+		if scores, score, ok := infrastructure.ScoreAnswer(criteria, feedbackData.Criteria, answer); ok {
+			feedback.CriterionScores = scores
+			feedback.ScorePercentage = score
+		}
+		if len(details) > 0 {
+			feedback.Details = details
+		}
 
-		// Add feedback to room
-		room.Feedback = append(room.Feedback, feedback)
+		// Behavioral answers are also broken down with the STAR method
+		if room.InterviewType == domain.InterviewTypeBehavioral {
+			feedback.STAR = r.analyseSTAR(model, question.Text, answer)
+		}
+
+		// Answers given after a hint or model answer score lower
+		if len(used) > 0 {
+			feedback.AssistanceUsed = used
+			feedback.ScorePenalty = infrastructure.AssistancePenalty(used)
+			if feedback.ScorePenalty > feedback.ScorePercentage {
+				feedback.ScorePenalty = feedback.ScorePercentage
+			}
+			feedback.ScorePercentage -= feedback.ScorePenalty
+		}
 
-		// Update performance percentage
-		room.PerformancePercentage += int64(feedback.ScorePercentage)
+		feedbacks = append(feedbacks, feedback)
 	}
 
-	// Calculate average performance percentage
-	if len(room.Feedback) > 0 {
-		room.PerformancePercentage = room.PerformancePercentage / int64(len(room.Feedback))
+	// Combine the answers through the rubric weights, see infrastructure.ScoreRoom
+	run.PerformancePercentage, run.CriterionScores = infrastructure.ScoreRoom(criteria, feedbacks)
+	run.FeedbackCount = len(feedbacks)
+	return run, feedbacks, nil
+}
+
+// saveFeedbackRun stores a run and its feedback
+func (r *roomRepository) saveFeedbackRun(c context.Context, run domain.FeedbackRun, feedbacks []domain.Feedback) error {
+	_, err := r.database.Collection(domain.CollectionFeedbackRun).InsertOne(c, run)
+	if err != nil {
+		return fmt.Errorf("failed to save feedback run: %v", err)
 	}
 
 	// Save feedbacks to feedback collection
 	feedbackCollection := r.database.Collection(domain.FeedbackCollection)
-	for _, feedback := range room.Feedback {
+	for _, feedback := range feedbacks {
 		_, err := feedbackCollection.InsertOne(c, feedback)
 		if err != nil {
-			return domain.Room{}, fmt.Errorf("failed to save feedback: %v", err)
+			return fmt.Errorf("failed to save feedback: %v", err)
 		}
 	}
+	return nil
+}
+
+// applyFeedbackRun makes a run the one the room shows
+func applyFeedbackRun(room *domain.Room, run domain.FeedbackRun, feedbacks []domain.Feedback) {
+	room.CanonicalRunID = run.ID
+	room.Feedback = feedbacks
+	room.PerformancePercentage = run.PerformancePercentage
+	room.AIPerformancePercentage = run.PerformancePercentage
+	room.ScoreSource = domain.ScoreSourceAI
+	room.CriterionScores = run.CriterionScores
+}
 
-	// Update room in database
-	_, err = collection.UpdateOne(c, bson.M{"_id": objectID}, bson.M{"$set": room})
+// CompletedRoom implements domain.RoomRepository.
+// The first call grades the room; later calls return it unchanged, use ReevaluateRoom to grade it again.
+// The room is claimed for grading first, so concurrent calls do not grade it twice.
+func (r *roomRepository) CompletedRoom(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, error) {
+	objectID, err := primitive.ObjectIDFromHex(roomID)
 	if err != nil {
+		return domain.Room{}, fmt.Errorf("invalid room ID format: %v", err)
+	}
+
+	collection := r.database.Collection(r.collection)
+	var room domain.Room
+	err = collection.FindOneAndUpdate(
+		c,
+		bson.M{"_id": objectID, "user_id": userID, "status": bson.M{"$nin": bson.A{"completed", roomStatusGrading}}},
+		bson.M{"$set": bson.M{"status": roomStatusGrading}},
+	).Decode(&room)
+	if err == mongo.ErrNoDocuments {
+		room, err := r.ownedRoom(c, userID, roomID)
+		if err != nil {
+			return domain.Room{}, err
+		}
+		if room.Status == roomStatusGrading {
+			return domain.Room{}, fmt.Errorf("room is being completed")
+		}
+		return room, nil
+	}
+	if err != nil {
+		return domain.Room{}, err
+	}
+
+	release := func() {
+		// Let the candidate try again, or carry on with the interview
+		if _, err := collection.UpdateOne(c, bson.M{"_id": room.ID, "status": roomStatusGrading}, bson.M{"$set": bson.M{"status": room.Status}}); err != nil {
+			log.Printf("failed to release room %s after grading failed: %v", room.ID.Hex(), err)
+		}
+	}
+
+	run, feedbacks, err := r.evaluateRoom(c, room, domain.DefaultGeminiModel)
+	if err != nil {
+		release()
 		return domain.Room{}, err
 	}
+	if err := r.saveFeedbackRun(c, run, feedbacks); err != nil {
+		release()
+		return domain.Room{}, err
+	}
+
+	room.Status = "completed"
+	room.CompletedAt = time.Now().Unix()
+	applyFeedbackRun(&room, run, feedbacks)
+
+	// Only the grading is written, the rest of the room may have changed meanwhile
+	_, err = collection.UpdateOne(c, bson.M{"_id": room.ID, "status": roomStatusGrading}, bson.M{"$set": bson.M{
+		"status":                    room.Status,
+		"completed_at":              room.CompletedAt,
+		"canonical_run_id":          room.CanonicalRunID,
+		"feedback":                  room.Feedback,
+		"performance_percentage":    room.PerformancePercentage,
+		"ai_performance_percentage": room.AIPerformancePercentage,
+		"score_source":              room.ScoreSource,
+		"criterion_scores":          room.CriterionScores,
+	}})
+	if err != nil {
+		return domain.Room{}, err
+	}
+
+	// A completed room may meet goals or earn badges; the room itself is already saved
+	if _, err := r.goalRepository.EvaluateGoals(c, room.UserID); err != nil {
+		log.Printf("failed to evaluate goals: %v", err)
+	}
+
+	return room, nil
+}
 
+// validGeminiModel matches Gemini model names such as gemini-2.0-flash
+var validGeminiModel = regexp.MustCompile(`^[a-z0-9][a-z0-9.\-]*$`)
+
+// ReevaluateRoom implements domain.RoomRepository.
+// The new run is stored next to the earlier ones; the room keeps its canonical run.
+func (r *roomRepository) ReevaluateRoom(c context.Context, userID primitive.ObjectID, roomID string, model string) (domain.FeedbackRun, error) {
+	if model == "" {
+		model = domain.DefaultGeminiModel
+	}
+	if !validGeminiModel.MatchString(model) {
+		return domain.FeedbackRun{}, fmt.Errorf("invalid model name")
+	}
+
+	room, err := r.ownedRoom(c, userID, roomID)
+	if err != nil {
+		return domain.FeedbackRun{}, err
+	}
+	if room.Status != "completed" {
+		return domain.FeedbackRun{}, fmt.Errorf("room is not completed yet")
+	}
+
+	run, feedbacks, err := r.evaluateRoom(c, room, model)
+	if err != nil {
+		return domain.FeedbackRun{}, err
+	}
+	if err := r.saveFeedbackRun(c, run, feedbacks); err != nil {
+		return domain.FeedbackRun{}, err
+	}
+
+	run.Feedback = feedbacks
+	return run, nil
+}
+
+// GetFeedbackRuns implements domain.RoomRepository.
+func (r *roomRepository) GetFeedbackRuns(c context.Context, userID primitive.ObjectID, roomID string) ([]domain.FeedbackRun, error) {
+	room, err := r.ownedRoom(c, userID, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := r.database.Collection(domain.CollectionFeedbackRun).Find(
+		c,
+		bson.M{"room_id": room.ID},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := []domain.FeedbackRun{}
+	if err := cursor.All(c, &runs); err != nil {
+		return nil, err
+	}
+	for i := range runs {
+		runs[i].Canonical = runs[i].ID == room.CanonicalRunID
+	}
+	return runs, nil
+}
+
+// feedbackRun loads a run of the room together with its feedback
+func (r *roomRepository) feedbackRun(c context.Context, room domain.Room, runID string) (domain.FeedbackRun, error) {
+	runObjectID, err := primitive.ObjectIDFromHex(runID)
+	if err != nil {
+		return domain.FeedbackRun{}, fmt.Errorf("invalid run ID format: %v", err)
+	}
+
+	var run domain.FeedbackRun
+	err = r.database.Collection(domain.CollectionFeedbackRun).FindOne(c, bson.M{"_id": runObjectID, "room_id": room.ID}).Decode(&run)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.FeedbackRun{}, fmt.Errorf("feedback run not found")
+		}
+		return domain.FeedbackRun{}, err
+	}
+
+	// Feedback has no bson tags, so its fields are stored under their lowercased names
+	cursor, err := r.database.Collection(domain.FeedbackCollection).Find(
+		c,
+		bson.M{"runid": run.ID},
+		options.Find().SetSort(bson.D{{Key: "createdat", Value: 1}}),
+	)
+	if err != nil {
+		return domain.FeedbackRun{}, err
+	}
+	run.Feedback = []domain.Feedback{}
+	if err := cursor.All(c, &run.Feedback); err != nil {
+		return domain.FeedbackRun{}, err
+	}
+	run.Canonical = run.ID == room.CanonicalRunID
+	return run, nil
+}
+
+// GetFeedbackRun implements domain.RoomRepository.
+func (r *roomRepository) GetFeedbackRun(c context.Context, userID primitive.ObjectID, roomID string, runID string) (domain.FeedbackRun, error) {
+	room, err := r.ownedRoom(c, userID, roomID)
+	if err != nil {
+		return domain.FeedbackRun{}, err
+	}
+	return r.feedbackRun(c, room, runID)
+}
+
+// SetCanonicalRun implements domain.RoomRepository.
+func (r *roomRepository) SetCanonicalRun(c context.Context, userID primitive.ObjectID, roomID string, runID string) (domain.Room, error) {
+	room, err := r.ownedRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+
+	run, err := r.feedbackRun(c, room, runID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	applyFeedbackRun(&room, run, run.Feedback)
+
+	// Coach overrides follow the answers into the new run
+	cursor, err := r.database.Collection(domain.CollectionScoreOverride).Find(c, bson.M{"room_id": room.ID})
+	if err != nil {
+		return domain.Room{}, err
+	}
+	var overrides []domain.ScoreOverride
+	if err := cursor.All(c, &overrides); err != nil {
+		return domain.Room{}, err
+	}
+	infrastructure.ApplyScoreOverrides(&room, overrides)
+
+	collection := r.database.Collection(r.collection)
+	_, err = collection.UpdateOne(c, bson.M{"_id": room.ID}, bson.M{"$set": bson.M{
+		"canonical_run_id":          room.CanonicalRunID,
+		"feedback":                  room.Feedback,
+		"performance_percentage":    room.PerformancePercentage,
+		"ai_performance_percentage": room.AIPerformancePercentage,
+		"score_source":              room.ScoreSource,
+		"criterion_scores":          room.CriterionScores,
+	}})
+	if err != nil {
+		return domain.Room{}, err
+	}
+	return room, nil
+}
+
+// ownedRoom loads a room of the user
+func (r *roomRepository) ownedRoom(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, error) {
+	objectID, err := primitive.ObjectIDFromHex(roomID)
+	if err != nil {
+		return domain.Room{}, fmt.Errorf("invalid room ID format: %v", err)
+	}
+
+	collection := r.database.Collection(r.collection)
+	var room domain.Room
+	err = collection.FindOne(c, bson.M{"_id": objectID, "user_id": userID}).Decode(&room)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.Room{}, fmt.Errorf("room not found")
+		}
+		return domain.Room{}, err
+	}
+	return room, nil
+}
+
+// GetUserRoom implements domain.RoomRepository.
+// Unlike GetRoom it only finds rooms that belong to the user.
+func (r *roomRepository) GetUserRoom(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, error) {
+	return r.ownedRoom(c, userID, roomID)
+}
+
+// editableRoom loads a room of the user that is still in progress
+func (r *roomRepository) editableRoom(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, error) {
+	room, err := r.ownedRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	if room.Status == "completed" {
+		return domain.Room{}, fmt.Errorf("room is already completed")
+	}
+	if room.Status == roomStatusGrading {
+		return domain.Room{}, fmt.Errorf("room is being completed")
+	}
+	return room, nil
+}
+
+// saveMessages stores the messages and question progress of a room that had loaded
+// messages when it was read. It fails instead of overwriting messages that were
+// added in the meantime, such as a voice answer that finished transcribing.
+func (r *roomRepository) saveMessages(c context.Context, room domain.Room, loaded int) error {
+	collection := r.database.Collection(r.collection)
+	result, err := collection.UpdateOne(
+		c,
+		bson.M{"_id": room.ID, "messages": bson.M{"$size": loaded}},
+		bson.M{"$set": bson.M{"messages": room.Messages, "current_question": room.CurrentQuestion}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("the room changed while it was being updated, try again")
+	}
+	return nil
+}
+
+// lastActiveMessage returns the index of the sender's last interview message that was not retracted, or -1
+func lastActiveMessage(messages []domain.Message, sender string) int {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if !messages[i].Retracted && !infrastructure.IsAssistance(messages[i]) && messages[i].Sender == sender {
+			return i
+		}
+	}
+	return -1
+}
+
+// currentQuestionOf works out which planned question the room's active messages are on
+func currentQuestionOf(room domain.Room) int {
+	current := 0
+	for i, msg := range infrastructure.InterviewMessages(room.Messages) {
+		if msg.Sender != "ai" {
+			continue
+		}
+		if msg.QuestionID.IsZero() {
+			// Only the closing message of a planned room has no question
+			if i > 0 {
+				current = len(room.PlannedQuestions)
+			}
+			continue
+		}
+		for j := range room.PlannedQuestions {
+			if room.PlannedQuestions[j].QuestionID == msg.QuestionID {
+				current = j
+			}
+		}
+	}
+	return current
+}
+
+// versionOf snapshots the current text of a message before it is replaced
+func versionOf(message domain.Message) domain.MessageVersion {
+	return domain.MessageVersion{
+		Text:       message.Text,
+		VoiceURL:   message.VoiceURL,
+		Timestamp:  message.Timestamp,
+		ReplacedAt: time.Now().Unix(),
+	}
+}
+
+// reviseInterviewerMessage generates the interviewer message at index again from the
+// conversation before it. The message keeps its ID and its previous text as a version.
+func (r *roomRepository) reviseInterviewerMessage(c context.Context, room *domain.Room, index int) error {
+	draft := *room
+	draft.Messages = room.Messages[:index]
+	draft.CurrentQuestion = currentQuestionOf(draft)
+
+	template, err := r.roomTemplate(c, draft)
+	if err != nil {
+		return fmt.Errorf("failed to load interview template: %v", err)
+	}
+	interviewer, err := r.roomInterviewer(c, draft, template)
+	if err != nil {
+		return err
+	}
+
+	revised, err := r.nextInterviewerMessage(interviewer, &draft)
+	if err != nil {
+		return err
+	}
+
+	previous := room.Messages[index]
+	revised.ID = previous.ID
+	revised.Versions = append(previous.Versions, versionOf(previous))
+	if previous.AudioURL != "" {
+		// The cached audio speaks the old text
+		if err := r.messageAudioRepository.ForgetMessageAudio(c, room.UserID, previous.ID); err != nil {
+			log.Printf("failed to remove audio of message %s: %v", previous.ID.Hex(), err)
+		}
+	}
+	r.speak(c, *room, &revised)
+
+	// Hints and model answers shown after the message stay active: the candidate has
+	// seen them, so they still count against the answer to the revised question
+	room.Messages[index] = revised
+	room.CurrentQuestion = draft.CurrentQuestion
+	return nil
+}
+
+// RegenerateLastMessage implements domain.RoomRepository.
+func (r *roomRepository) RegenerateLastMessage(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, error) {
+	room, err := r.editableRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	loaded := len(room.Messages)
+
+	last := lastActiveMessage(room.Messages, "ai")
+	if last == -1 || last < lastActiveMessage(room.Messages, "user") {
+		return domain.Room{}, fmt.Errorf("the last message is not from the interviewer")
+	}
+	if len(infrastructure.InterviewMessages(room.Messages[:last])) == 0 {
+		return domain.Room{}, fmt.Errorf("the opening message cannot be regenerated")
+	}
+
+	if err := r.reviseInterviewerMessage(c, &room, last); err != nil {
+		return domain.Room{}, err
+	}
+	if err := r.saveMessages(c, room, loaded); err != nil {
+		return domain.Room{}, err
+	}
+	return room, nil
+}
+
+// EditLastAnswer implements domain.RoomRepository.
+// The interviewer's reply to the answer is regenerated for the new text.
+func (r *roomRepository) EditLastAnswer(c context.Context, userID primitive.ObjectID, roomID string, text string) (domain.Room, error) {
+	if strings.TrimSpace(text) == "" {
+		return domain.Room{}, fmt.Errorf("answer text is required")
+	}
+
+	room, err := r.editableRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	loaded := len(room.Messages)
+
+	answer := lastActiveMessage(room.Messages, "user")
+	if answer == -1 {
+		return domain.Room{}, fmt.Errorf("there is no answer to edit")
+	}
+
+	edited := &room.Messages[answer]
+	edited.Versions = append(edited.Versions, versionOf(*edited))
+	edited.Text = text
+	edited.Timestamp = time.Now().Unix()
+	// Voice and delivery details describe the recording, not the edited text
+	edited.VoiceURL = ""
+	edited.DeliveryMetrics = nil
+
+	reply := -1
+	for i := answer + 1; i < len(room.Messages); i++ {
+		if !room.Messages[i].Retracted && !infrastructure.IsAssistance(room.Messages[i]) && room.Messages[i].Sender == "ai" {
+			reply = i
+			break
+		}
+	}
+
+	if reply != -1 {
+		err = r.reviseInterviewerMessage(c, &room, reply)
+	} else {
+		err = r.appendInterviewerMessage(c, &room)
+	}
+	if err != nil {
+		return domain.Room{}, err
+	}
+
+	if err := r.saveMessages(c, room, loaded); err != nil {
+		return domain.Room{}, err
+	}
+	return room, nil
+}
+
+// appendInterviewerMessage adds the interviewer's reply to the end of the conversation
+func (r *roomRepository) appendInterviewerMessage(c context.Context, room *domain.Room) error {
+	template, err := r.roomTemplate(c, *room)
+	if err != nil {
+		return fmt.Errorf("failed to load interview template: %v", err)
+	}
+	interviewer, err := r.roomInterviewer(c, *room, template)
+	if err != nil {
+		return err
+	}
+
+	aiMessage, err := r.nextInterviewerMessage(interviewer, room)
+	if err != nil {
+		return err
+	}
+	r.speak(c, *room, &aiMessage)
+	room.Messages = append(room.Messages, aiMessage)
+	return nil
+}
+
+// RetractLastAnswer implements domain.RoomRepository.
+// The answer and the interviewer's reply stay in the room but are ignored from then on,
+// so the candidate answers the previous question again.
+func (r *roomRepository) RetractLastAnswer(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, error) {
+	room, err := r.editableRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	loaded := len(room.Messages)
+
+	answer := lastActiveMessage(room.Messages, "user")
+	if answer == -1 {
+		return domain.Room{}, fmt.Errorf("there is no answer to retract")
+	}
+
+	for i := answer; i < len(room.Messages); i++ {
+		room.Messages[i].Retracted = true
+	}
+	room.CurrentQuestion = currentQuestionOf(room)
+
+	if err := r.saveMessages(c, room, loaded); err != nil {
+		return domain.Room{}, err
+	}
+	return room, nil
+}
+
+// RequestAssistance implements domain.RoomRepository.
+// It adds a hint or a model answer for the question the candidate has not answered yet.
+func (r *roomRepository) RequestAssistance(c context.Context, userID primitive.ObjectID, roomID string, assistanceType string) (domain.Room, error) {
+	if assistanceType != domain.MessageTypeHint && assistanceType != domain.MessageTypeModelAnswer {
+		return domain.Room{}, fmt.Errorf("unknown assistance type")
+	}
+
+	room, err := r.editableRoom(c, userID, roomID)
+	if err != nil {
+		return domain.Room{}, err
+	}
+	loaded := len(room.Messages)
+
+	question := lastActiveMessage(room.Messages, "ai")
+	if question == -1 || question < lastActiveMessage(room.Messages, "user") {
+		return domain.Room{}, fmt.Errorf("there is no open question")
+	}
+	questionMessage := room.Messages[question]
+
+	template, err := r.roomTemplate(c, room)
+	if err != nil {
+		return domain.Room{}, fmt.Errorf("failed to load interview template: %v", err)
+	}
+
+	messageHistory := infrastructure.BuildMessageHistory(room)
+	planned := plannedQuestion(room, questionMessage.QuestionID)
+	prompt := infrastructure.BuildHintPrompt(template, room, messageHistory, questionMessage.Text, planned)
+	if assistanceType == domain.MessageTypeModelAnswer {
+		prompt = infrastructure.BuildModelAnswerPrompt(template, room, messageHistory, questionMessage.Text, planned)
+	}
+
+	response, err := r.geminiRepository.GenerateResponse(infrastructure.BuildGeminiRequest(prompt))
+	if err != nil {
+		return domain.Room{}, fmt.Errorf("failed to generate %s: %v", strings.ReplaceAll(assistanceType, "_", " "), err)
+	}
+
+	message := domain.Message{
+		ID:         primitive.NewObjectID(),
+		Sender:     "ai",
+		Text:       response,
+		Type:       assistanceType,
+		QuestionID: questionMessage.QuestionID,
+		Timestamp:  time.Now().Unix(),
+	}
+	r.speak(c, room, &message)
+	room.Messages = append(room.Messages, message)
+
+	if err := r.saveMessages(c, room, loaded); err != nil {
+		return domain.Room{}, err
+	}
 	return room, nil
 }
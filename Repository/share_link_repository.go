@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Lifetime of share links in hours
+const (
+	defaultShareLinkHours = 7 * 24
+	maxShareLinkHours     = 30 * 24
+)
+
+type shareLinkRepository struct {
+	database       mongo.Database
+	collection     string
+	roomRepository domain.RoomRepository
+}
+
+func NewShareLinkRepository(database mongo.Database, collection string, roomRepository domain.RoomRepository) domain.ShareLinkRepository {
+	return &shareLinkRepository{
+		database:       database,
+		collection:     collection,
+		roomRepository: roomRepository,
+	}
+}
+
+// newShareToken returns an unguessable URL-safe token
+func newShareToken() (string, error) {
+	token := make([]byte, 24)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to create share token: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// CreateShareLink implements domain.ShareLinkRepository.
+func (s *shareLinkRepository) CreateShareLink(c context.Context, userID primitive.ObjectID, roomID string, request domain.ShareLinkRequest) (domain.ShareLink, error) {
+	hours := request.ExpiresInHours
+	if hours == 0 {
+		hours = defaultShareLinkHours
+	}
+	if hours < 0 || hours > maxShareLinkHours {
+		return domain.ShareLink{}, fmt.Errorf("expires_in_hours must be between 1 and %d", maxShareLinkHours)
+	}
+
+	room, err := s.roomRepository.GetUserRoom(c, userID, roomID)
+	if err != nil {
+		return domain.ShareLink{}, err
+	}
+	if room.Status != "completed" {
+		return domain.ShareLink{}, fmt.Errorf("only completed rooms can be shared")
+	}
+
+	token, err := newShareToken()
+	if err != nil {
+		return domain.ShareLink{}, err
+	}
+	now := time.Now()
+	link := domain.ShareLink{
+		ID:         primitive.NewObjectID(),
+		RoomID:     room.ID,
+		UserID:     userID,
+		Token:      token,
+		RedactName: request.RedactName,
+		ExpiresAt:  now.Add(time.Duration(hours) * time.Hour).Unix(),
+		CreatedAt:  now.Unix(),
+	}
+	_, err = s.database.Collection(s.collection).InsertOne(c, link)
+	if err != nil {
+		return domain.ShareLink{}, fmt.Errorf("failed to create share link: %v", err)
+	}
+	return link, nil
+}
+
+// GetShareLinks implements domain.ShareLinkRepository.
+// It returns every link of the room, newest first, including revoked and expired ones.
+func (s *shareLinkRepository) GetShareLinks(c context.Context, userID primitive.ObjectID, roomID string) ([]domain.ShareLink, error) {
+	room, err := s.roomRepository.GetUserRoom(c, userID, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := s.database.Collection(s.collection).Find(c, bson.M{"room_id": room.ID, "user_id": userID}, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	links := []domain.ShareLink{}
+	if err := cursor.All(c, &links); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// RevokeShareLink implements domain.ShareLinkRepository.
+// Revoking a link twice keeps the first revocation time.
+func (s *shareLinkRepository) RevokeShareLink(c context.Context, userID primitive.ObjectID, roomID string, linkID string) error {
+	room, err := s.roomRepository.GetUserRoom(c, userID, roomID)
+	if err != nil {
+		return err
+	}
+	objectID, err := primitive.ObjectIDFromHex(linkID)
+	if err != nil {
+		return fmt.Errorf("invalid share link ID format: %v", err)
+	}
+
+	collection := s.database.Collection(s.collection)
+	var link domain.ShareLink
+	err = collection.FindOne(c, bson.M{"_id": objectID, "room_id": room.ID, "user_id": userID}).Decode(&link)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return fmt.Errorf("share link not found")
+		}
+		return err
+	}
+	if link.RevokedAt != 0 {
+		return nil
+	}
+
+	_, err = collection.UpdateOne(c, bson.M{"_id": link.ID}, bson.M{"$set": bson.M{"revoked_at": time.Now().Unix()}})
+	return err
+}
+
+// GetSharedReport implements domain.ShareLinkRepository.
+// It needs no user: the token is the permission. Every successful read is counted.
+func (s *shareLinkRepository) GetSharedReport(c context.Context, token string) (domain.Report, error) {
+	collection := s.database.Collection(s.collection)
+	now := time.Now().Unix()
+
+	var link domain.ShareLink
+	err := collection.FindOneAndUpdate(
+		c,
+		bson.M{"token": token, "revoked_at": bson.M{"$exists": false}, "expires_at": bson.M{"$gt": now}},
+		bson.M{"$inc": bson.M{"access_count": 1}, "$set": bson.M{"last_accessed_at": now}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&link)
+	if err == mongo.ErrNoDocuments {
+		// Tell revoked and expired links apart from tokens that never existed
+		count, countErr := collection.CountDocuments(c, bson.M{"token": token})
+		if countErr != nil {
+			return domain.Report{}, countErr
+		}
+		if count > 0 {
+			return domain.Report{}, fmt.Errorf("share link is no longer available")
+		}
+		return domain.Report{}, fmt.Errorf("share link not found")
+	}
+	if err != nil {
+		return domain.Report{}, err
+	}
+
+	room, err := s.roomRepository.GetRoom(c, link.RoomID.Hex())
+	if err != nil {
+		if err.Error() == "room not found" {
+			return domain.Report{}, fmt.Errorf("share link not found")
+		}
+		return domain.Report{}, err
+	}
+
+	var user domain.User
+	err = s.database.Collection(domain.CollectionUser).FindOne(c, bson.M{"_id": room.UserID}).Decode(&user)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return domain.Report{}, fmt.Errorf("failed to load user: %v", err)
+	}
+	return infrastructure.BuildReport(room, user.Username, link.RedactName), nil
+}
@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var skillKeyPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type skillRepository struct {
+	database         mongo.Database
+	collection       string
+	geminiRepository domain.GeminiRepository
+}
+
+func NewSkillRepository(database mongo.Database, collection string, geminiRepository domain.GeminiRepository) domain.SkillRepository {
+	return &skillRepository{
+		database:         database,
+		collection:       collection,
+		geminiRepository: geminiRepository,
+	}
+}
+
+// validateSkill checks a skill against the rest of the taxonomy: its parent must
+// exist and must not be the skill itself or one of its descendants
+func validateSkill(skill *domain.Skill, skills []domain.Skill) error {
+	if !skillKeyPattern.MatchString(skill.Key) {
+		return fmt.Errorf("skill key must only contain lowercase letters, digits and underscores")
+	}
+	skill.Name = strings.TrimSpace(skill.Name)
+	if skill.Name == "" {
+		return fmt.Errorf("skill name is required")
+	}
+
+	aliases := []string{}
+	for _, alias := range skill.Aliases {
+		if alias = strings.ToLower(strings.TrimSpace(alias)); alias != "" {
+			aliases = append(aliases, alias)
+		}
+	}
+	skill.Aliases = aliases
+
+	if skill.ParentKey == "" {
+		return nil
+	}
+	parents := infrastructure.SkillParents(skills)
+	if _, ok := parents[skill.ParentKey]; !ok {
+		return fmt.Errorf("parent skill %s not found", skill.ParentKey)
+	}
+	parents[skill.Key] = skill.ParentKey
+	for _, ancestor := range infrastructure.SkillAncestors(parents, skill.ParentKey) {
+		if ancestor == skill.Key {
+			return fmt.Errorf("a skill cannot be its own ancestor")
+		}
+	}
+	return nil
+}
+
+// CreateSkill implements domain.SkillRepository.
+func (s *skillRepository) CreateSkill(c context.Context, skill domain.Skill) (domain.Skill, error) {
+	skills, err := s.GetSkills(c)
+	if err != nil {
+		return domain.Skill{}, err
+	}
+	for _, existing := range skills {
+		if existing.Key == skill.Key && !existing.ID.IsZero() {
+			return domain.Skill{}, fmt.Errorf("skill with key %s already exists", skill.Key)
+		}
+	}
+	if err := validateSkill(&skill, skills); err != nil {
+		return domain.Skill{}, err
+	}
+
+	skill.ID = primitive.NewObjectID()
+	skill.CreatedAt = time.Now().Unix()
+	skill.UpdatedAt = skill.CreatedAt
+
+	collection := s.database.Collection(s.collection)
+	_, err = collection.InsertOne(c, skill)
+	if err != nil {
+		return domain.Skill{}, fmt.Errorf("failed to create skill: %v", err)
+	}
+	return skill, nil
+}
+
+// GetSkills implements domain.SkillRepository.
+// Stored skills override built-in skills with the same key.
+func (s *skillRepository) GetSkills(c context.Context) ([]domain.Skill, error) {
+	collection := s.database.Collection(s.collection)
+	cursor, err := collection.Find(c, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	var stored []domain.Skill
+	if err := cursor.All(c, &stored); err != nil {
+		return nil, err
+	}
+
+	storedKeys := make(map[string]bool)
+	for _, skill := range stored {
+		storedKeys[skill.Key] = true
+	}
+
+	skills := []domain.Skill{}
+	for _, skill := range infrastructure.DefaultSkills() {
+		if !storedKeys[skill.Key] {
+			skills = append(skills, skill)
+		}
+	}
+	return append(skills, stored...), nil
+}
+
+// GetSkill implements domain.SkillRepository.
+func (s *skillRepository) GetSkill(c context.Context, key string) (domain.Skill, error) {
+	collection := s.database.Collection(s.collection)
+	var skill domain.Skill
+	err := collection.FindOne(c, bson.M{"key": key}).Decode(&skill)
+	if err == nil {
+		return skill, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return domain.Skill{}, err
+	}
+
+	if skill, ok := infrastructure.DefaultSkill(key); ok {
+		return skill, nil
+	}
+	return domain.Skill{}, fmt.Errorf("skill not found")
+}
+
+// UpdateSkill implements domain.SkillRepository.
+// Updating a built-in skill stores an override for it.
+func (s *skillRepository) UpdateSkill(c context.Context, key string, skill domain.Skill) (domain.Skill, error) {
+	existing, err := s.GetSkill(c, key)
+	if err != nil {
+		return domain.Skill{}, err
+	}
+	skills, err := s.GetSkills(c)
+	if err != nil {
+		return domain.Skill{}, err
+	}
+
+	skill.Key = key
+	if err := validateSkill(&skill, skills); err != nil {
+		return domain.Skill{}, err
+	}
+
+	skill.ID = existing.ID
+	skill.CreatedAt = existing.CreatedAt
+	skill.UpdatedAt = time.Now().Unix()
+	if skill.ID.IsZero() {
+		skill.ID = primitive.NewObjectID()
+		skill.CreatedAt = skill.UpdatedAt
+	}
+
+	collection := s.database.Collection(s.collection)
+	_, err = collection.ReplaceOne(c, bson.M{"_id": skill.ID}, skill, options.Replace().SetUpsert(true))
+	if err != nil {
+		return domain.Skill{}, err
+	}
+	return skill, nil
+}
+
+// DeleteSkill implements domain.SkillRepository.
+// Deleting an override of a built-in skill restores the built-in one.
+func (s *skillRepository) DeleteSkill(c context.Context, key string) error {
+	skills, err := s.GetSkills(c)
+	if err != nil {
+		return err
+	}
+	_, builtIn := infrastructure.DefaultSkill(key)
+	if !builtIn {
+		for _, skill := range skills {
+			if skill.ParentKey == key {
+				return fmt.Errorf("skill has child skills")
+			}
+		}
+	}
+
+	collection := s.database.Collection(s.collection)
+	result, err := collection.DeleteOne(c, bson.M{"key": key})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		if builtIn {
+			return fmt.Errorf("built-in skills cannot be deleted")
+		}
+		return fmt.Errorf("skill not found")
+	}
+	return nil
+}
+
+// TagText implements domain.SkillRepository.
+// Keyword rules are tried first; Gemini is only asked when they find nothing and useLLM is set.
+func (s *skillRepository) TagText(c context.Context, text string, useLLM bool) ([]string, error) {
+	skills, err := s.GetSkills(c)
+	if err != nil {
+		return nil, err
+	}
+	if tags := infrastructure.MatchSkills(skills, text); len(tags) > 0 || !useLLM || strings.TrimSpace(text) == "" {
+		return tags, nil
+	}
+
+	response, err := s.geminiRepository.GenerateResponse(infrastructure.BuildGeminiRequest(infrastructure.BuildSkillTaggingPrompt(skills, text)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to tag skills: %v", err)
+	}
+	return infrastructure.ParseSkillTags(skills, response)
+}
+
+// RetagAll implements domain.SkillRepository.
+// Bank questions, rooms and the canonical feedback of rooms are tagged again with the
+// keyword rules, so that taxonomy changes apply to existing content.
+func (s *skillRepository) RetagAll(c context.Context) (domain.SkillRetagResult, error) {
+	skills, err := s.GetSkills(c)
+	if err != nil {
+		return domain.SkillRetagResult{}, err
+	}
+	var result domain.SkillRetagResult
+
+	questions := s.database.Collection(domain.CollectionQuestionBank)
+	cursor, err := questions.Find(c, bson.M{})
+	if err != nil {
+		return domain.SkillRetagResult{}, err
+	}
+	var bank []domain.Question
+	if err := cursor.All(c, &bank); err != nil {
+		return domain.SkillRetagResult{}, err
+	}
+	for _, question := range bank {
+		tags := infrastructure.MatchSkills(skills, question.Text+" "+question.Topic+" "+strings.Join(question.Tags, " "))
+		_, err := questions.UpdateOne(c, bson.M{"_id": question.ID}, bson.M{"$set": bson.M{"skills": tags}})
+		if err != nil {
+			return domain.SkillRetagResult{}, fmt.Errorf("failed to tag question: %v", err)
+		}
+		result.Questions++
+	}
+
+	rooms := s.database.Collection(domain.CollectionRoom)
+	cursor, err = rooms.Find(c, bson.M{})
+	if err != nil {
+		return domain.SkillRetagResult{}, err
+	}
+	var stored []domain.Room
+	if err := cursor.All(c, &stored); err != nil {
+		return domain.SkillRetagResult{}, err
+	}
+	for _, room := range stored {
+		room.Skills = infrastructure.MatchSkills(skills, room.Role+" "+room.Topic)
+		update := bson.M{"skills": room.Skills}
+		for i, feedback := range room.Feedback {
+			update[fmt.Sprintf("feedback.%d.skills", i)] = infrastructure.AnswerSkills(skills, room, feedback)
+		}
+		_, err := rooms.UpdateOne(c, bson.M{"_id": room.ID}, bson.M{"$set": update})
+		if err != nil {
+			return domain.SkillRetagResult{}, fmt.Errorf("failed to tag room: %v", err)
+		}
+		result.Rooms++
+	}
+	return result, nil
+}
+
+// GetCompetencyMap implements domain.SkillRepository.
+func (s *skillRepository) GetCompetencyMap(c context.Context, userID primitive.ObjectID) ([]domain.SkillCompetency, error) {
+	skills, err := s.GetSkills(c)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := s.database.Collection(domain.CollectionRoom).Find(c, bson.M{"user_id": userID, "status": "completed"})
+	if err != nil {
+		return nil, err
+	}
+	var rooms []domain.Room
+	if err := cursor.All(c, &rooms); err != nil {
+		return nil, err
+	}
+	return infrastructure.BuildCompetencyMap(skills, rooms), nil
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	infrastructure "github.com/chachidani/interview-coach-backend/Infrastructure"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// voiceProcessingTimeout bounds transcription plus the interviewer's reply.
+// It is independent of the request timeout because processing outlives the request.
+const voiceProcessingTimeout = 2 * time.Minute
+
+type voiceAnswerRepository struct {
+	database       mongo.Database
+	collection     string
+	blobStore      domain.BlobStore
+	speechToText   domain.SpeechToText
+	roomRepository domain.RoomRepository
+}
+
+func NewVoiceAnswerRepository(database mongo.Database, collection string, blobStore domain.BlobStore, speechToText domain.SpeechToText, roomRepository domain.RoomRepository) domain.VoiceAnswerRepository {
+	return &voiceAnswerRepository{
+		database:       database,
+		collection:     collection,
+		blobStore:      blobStore,
+		speechToText:   speechToText,
+		roomRepository: roomRepository,
+	}
+}
+
+// SubmitVoiceAnswer implements domain.VoiceAnswerRepository.
+func (v *voiceAnswerRepository) SubmitVoiceAnswer(c context.Context, userID primitive.ObjectID, roomID string, upload domain.AudioUpload) (domain.VoiceAnswer, error) {
+	room, err := v.roomRepository.GetUserRoom(c, userID, roomID)
+	if err != nil {
+		return domain.VoiceAnswer{}, err
+	}
+	if room.Status == "completed" {
+		return domain.VoiceAnswer{}, fmt.Errorf("room is already completed")
+	}
+	if room.Status == roomStatusGrading {
+		return domain.VoiceAnswer{}, fmt.Errorf("room is being completed")
+	}
+
+	now := time.Now().Unix()
+	voiceAnswer := domain.VoiceAnswer{
+		ID:          primitive.NewObjectID(),
+		RoomID:      room.ID,
+		UserID:      userID,
+		ContentType: upload.ContentType,
+		Status:      domain.VoiceAnswerStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	voiceAnswer.AudioKey = fmt.Sprintf("voice/%s/%s/%s%s", userID.Hex(), room.ID.Hex(), voiceAnswer.ID.Hex(), strings.ToLower(filepath.Ext(upload.Filename)))
+
+	voiceAnswer.AudioURL, err = v.blobStore.Put(c, voiceAnswer.AudioKey, upload.ContentType, bytes.NewReader(upload.Content))
+	if err != nil {
+		return domain.VoiceAnswer{}, fmt.Errorf("failed to store audio: %v", err)
+	}
+
+	collection := v.database.Collection(v.collection)
+	_, err = collection.InsertOne(c, voiceAnswer)
+	if err != nil {
+		return domain.VoiceAnswer{}, fmt.Errorf("failed to save voice answer: %v", err)
+	}
+
+	go v.processVoiceAnswer(voiceAnswer)
+
+	return voiceAnswer, nil
+}
+
+// processVoiceAnswer transcribes the audio and appends it to the room as the user's answer
+func (v *voiceAnswerRepository) processVoiceAnswer(voiceAnswer domain.VoiceAnswer) {
+	c, cancel := context.WithTimeout(context.Background(), voiceProcessingTimeout)
+	defer cancel()
+
+	fail := func(err error) {
+		log.Printf("voice answer %s failed: %v", voiceAnswer.ID.Hex(), err)
+		v.updateStatus(c, voiceAnswer.ID, bson.M{"status": domain.VoiceAnswerStatusFailed, "error": err.Error()})
+	}
+
+	v.updateStatus(c, voiceAnswer.ID, bson.M{"status": domain.VoiceAnswerStatusProcessing})
+
+	audio, contentType, err := v.blobStore.Get(c, voiceAnswer.AudioKey)
+	if err != nil {
+		fail(fmt.Errorf("failed to read audio: %v", err))
+		return
+	}
+	defer audio.Close()
+
+	transcript, err := v.speechToText.Transcribe(c, audio, contentType)
+	if err != nil {
+		fail(fmt.Errorf("failed to transcribe audio: %v", err))
+		return
+	}
+	if strings.TrimSpace(transcript.Text) == "" {
+		fail(fmt.Errorf("no speech was recognised in the recording"))
+		return
+	}
+
+	metrics := infrastructure.ComputeDeliveryMetrics(transcript)
+	messageID := primitive.NewObjectID()
+	_, err = v.roomRepository.AddMessageToRoom(c, voiceAnswer.UserID, voiceAnswer.RoomID.Hex(), domain.Message{
+		ID:              messageID,
+		Sender:          "user",
+		Text:            transcript.Text,
+		VoiceURL:        voiceAnswer.AudioURL,
+		DeliveryMetrics: &metrics,
+		Timestamp:       time.Now().Unix(),
+	})
+	if err != nil {
+		fail(fmt.Errorf("failed to add answer to room: %v", err))
+		return
+	}
+
+	v.updateStatus(c, voiceAnswer.ID, bson.M{
+		"status":     domain.VoiceAnswerStatusCompleted,
+		"transcript": transcript.Text,
+		"message_id": messageID,
+	})
+}
+
+func (v *voiceAnswerRepository) updateStatus(c context.Context, voiceAnswerID primitive.ObjectID, fields bson.M) {
+	fields["updated_at"] = time.Now().Unix()
+	collection := v.database.Collection(v.collection)
+	if _, err := collection.UpdateOne(c, bson.M{"_id": voiceAnswerID}, bson.M{"$set": fields}); err != nil {
+		log.Printf("failed to update voice answer %s: %v", voiceAnswerID.Hex(), err)
+	}
+}
+
+// GetVoiceAnswer implements domain.VoiceAnswerRepository.
+func (v *voiceAnswerRepository) GetVoiceAnswer(c context.Context, userID primitive.ObjectID, voiceAnswerID string) (domain.VoiceAnswer, error) {
+	objectID, err := primitive.ObjectIDFromHex(voiceAnswerID)
+	if err != nil {
+		return domain.VoiceAnswer{}, fmt.Errorf("invalid voice answer ID format: %v", err)
+	}
+
+	collection := v.database.Collection(v.collection)
+	var voiceAnswer domain.VoiceAnswer
+	err = collection.FindOne(c, bson.M{"_id": objectID, "user_id": userID}).Decode(&voiceAnswer)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return domain.VoiceAnswer{}, fmt.Errorf("voice answer not found")
+		}
+		return domain.VoiceAnswer{}, err
+	}
+	return voiceAnswer, nil
+}
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type coachReviewUsecase struct {
+	coachReviewRepository domain.CoachReviewRepository
+	ContextTimeout        time.Duration
+}
+
+// GetAnnotations implements domain.CoachReviewUsecase.
+func (r *coachReviewUsecase) GetAnnotations(c context.Context, userID primitive.ObjectID, roomID string) ([]domain.AnnotationThread, error) {
+	return r.coachReviewRepository.GetAnnotations(c, userID, roomID)
+}
+
+// CreateAnnotation implements domain.CoachReviewUsecase.
+func (r *coachReviewUsecase) CreateAnnotation(c context.Context, userID primitive.ObjectID, roomID string, request domain.AnnotationRequest) (domain.Annotation, error) {
+	return r.coachReviewRepository.CreateAnnotation(c, userID, roomID, request)
+}
+
+// UpdateAnnotation implements domain.CoachReviewUsecase.
+func (r *coachReviewUsecase) UpdateAnnotation(c context.Context, userID primitive.ObjectID, roomID string, annotationID string, text string) (domain.Annotation, error) {
+	return r.coachReviewRepository.UpdateAnnotation(c, userID, roomID, annotationID, text)
+}
+
+// DeleteAnnotation implements domain.CoachReviewUsecase.
+func (r *coachReviewUsecase) DeleteAnnotation(c context.Context, userID primitive.ObjectID, roomID string, annotationID string) error {
+	return r.coachReviewRepository.DeleteAnnotation(c, userID, roomID, annotationID)
+}
+
+// OverrideScore implements domain.CoachReviewUsecase.
+func (r *coachReviewUsecase) OverrideScore(c context.Context, userID primitive.ObjectID, roomID string, feedbackID string, request domain.ScoreOverrideRequest) (domain.Room, error) {
+	return r.coachReviewRepository.OverrideScore(c, userID, roomID, feedbackID, request)
+}
+
+// RemoveScoreOverride implements domain.CoachReviewUsecase.
+func (r *coachReviewUsecase) RemoveScoreOverride(c context.Context, userID primitive.ObjectID, roomID string, feedbackID string) (domain.Room, error) {
+	return r.coachReviewRepository.RemoveScoreOverride(c, userID, roomID, feedbackID)
+}
+
+// GetScoreOverrides implements domain.CoachReviewUsecase.
+func (r *coachReviewUsecase) GetScoreOverrides(c context.Context, userID primitive.ObjectID, roomID string) ([]domain.ScoreOverride, error) {
+	return r.coachReviewRepository.GetScoreOverrides(c, userID, roomID)
+}
+
+func NewCoachReviewUsecase(coachReviewRepository domain.CoachReviewRepository, timeout time.Duration) domain.CoachReviewUsecase {
+	return &coachReviewUsecase{
+		coachReviewRepository: coachReviewRepository,
+		ContextTimeout:        timeout,
+	}
+}
@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type exportUsecase struct {
+	exportRepository domain.ExportRepository
+	ContextTimeout   time.Duration
+}
+
+// ExportRoom implements domain.ExportUsecase.
+func (e *exportUsecase) ExportRoom(c context.Context, userID primitive.ObjectID, roomID string, format string) (domain.ExportFile, error) {
+	return e.exportRepository.ExportRoom(c, userID, roomID, format)
+}
+
+// ExportRooms implements domain.ExportUsecase.
+func (e *exportUsecase) ExportRooms(c context.Context, userID primitive.ObjectID, format string) (domain.ExportFile, error) {
+	return e.exportRepository.ExportRooms(c, userID, format)
+}
+
+func NewExportUsecase(exportRepository domain.ExportRepository, timeout time.Duration) domain.ExportUsecase {
+	return &exportUsecase{
+		exportRepository: exportRepository,
+		ContextTimeout:   timeout,
+	}
+}
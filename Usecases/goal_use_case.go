@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type goalUsecase struct {
+	goalRepository domain.GoalRepository
+	ContextTimeout time.Duration
+}
+
+// CreateGoal implements domain.GoalUsecase.
+func (g *goalUsecase) CreateGoal(c context.Context, goal domain.Goal) (domain.Goal, error) {
+	return g.goalRepository.CreateGoal(c, goal)
+}
+
+// GetGoals implements domain.GoalUsecase.
+func (g *goalUsecase) GetGoals(c context.Context, userID primitive.ObjectID) ([]domain.Goal, error) {
+	return g.goalRepository.GetGoals(c, userID)
+}
+
+// DeleteGoal implements domain.GoalUsecase.
+func (g *goalUsecase) DeleteGoal(c context.Context, userID primitive.ObjectID, goalID string) error {
+	return g.goalRepository.DeleteGoal(c, userID, goalID)
+}
+
+// EvaluateGoals implements domain.GoalUsecase.
+func (g *goalUsecase) EvaluateGoals(c context.Context, userID primitive.ObjectID) (domain.GoalSummary, error) {
+	return g.goalRepository.EvaluateGoals(c, userID)
+}
+
+// GetGoalEvents implements domain.GoalUsecase.
+func (g *goalUsecase) GetGoalEvents(c context.Context, userID primitive.ObjectID) ([]domain.GoalEvent, error) {
+	return g.goalRepository.GetGoalEvents(c, userID)
+}
+
+func NewGoalUsecase(goalRepository domain.GoalRepository, timeout time.Duration) domain.GoalUsecase {
+	return &goalUsecase{
+		goalRepository: goalRepository,
+		ContextTimeout: timeout,
+	}
+}
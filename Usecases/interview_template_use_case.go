@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+type interviewTemplateUsecase struct {
+	interviewTemplateRepository domain.InterviewTemplateRepository
+	ContextTimeout              time.Duration
+}
+
+// CreateTemplate implements domain.InterviewTemplateUsecase.
+func (i *interviewTemplateUsecase) CreateTemplate(c context.Context, template domain.InterviewTemplate) (domain.InterviewTemplate, error) {
+	return i.interviewTemplateRepository.CreateTemplate(c, template)
+}
+
+// GetTemplates implements domain.InterviewTemplateUsecase.
+func (i *interviewTemplateUsecase) GetTemplates(c context.Context) ([]domain.InterviewTemplate, error) {
+	return i.interviewTemplateRepository.GetTemplates(c)
+}
+
+// GetLatestTemplate implements domain.InterviewTemplateUsecase.
+func (i *interviewTemplateUsecase) GetLatestTemplate(c context.Context, interviewType string) (domain.InterviewTemplate, error) {
+	return i.interviewTemplateRepository.GetLatestTemplate(c, interviewType)
+}
+
+// GetTemplateVersion implements domain.InterviewTemplateUsecase.
+func (i *interviewTemplateUsecase) GetTemplateVersion(c context.Context, interviewType string, version int) (domain.InterviewTemplate, error) {
+	return i.interviewTemplateRepository.GetTemplateVersion(c, interviewType, version)
+}
+
+func NewInterviewTemplateUsecase(interviewTemplateRepository domain.InterviewTemplateRepository, timeout time.Duration) domain.InterviewTemplateUsecase {
+	return &interviewTemplateUsecase{
+		interviewTemplateRepository: interviewTemplateRepository,
+		ContextTimeout:              timeout,
+	}
+}
@@ -0,0 +1,26 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type messageAudioUsecase struct {
+	messageAudioRepository domain.MessageAudioRepository
+	ContextTimeout         time.Duration
+}
+
+// GetMessageAudio implements domain.MessageAudioUsecase.
+func (m *messageAudioUsecase) GetMessageAudio(c context.Context, userID primitive.ObjectID, roomID string, messageID string) (string, error) {
+	return m.messageAudioRepository.GetMessageAudio(c, userID, roomID, messageID)
+}
+
+func NewMessageAudioUsecase(messageAudioRepository domain.MessageAudioRepository, timeout time.Duration) domain.MessageAudioUsecase {
+	return &messageAudioUsecase{
+		messageAudioRepository: messageAudioRepository,
+		ContextTimeout:         timeout,
+	}
+}
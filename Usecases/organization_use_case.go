@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type organizationUsecase struct {
+	organizationRepository domain.OrganizationRepository
+	ContextTimeout         time.Duration
+}
+
+// CreateOrganization implements domain.OrganizationUsecase.
+func (o *organizationUsecase) CreateOrganization(c context.Context, userID primitive.ObjectID, name string) (domain.Organization, error) {
+	return o.organizationRepository.CreateOrganization(c, userID, name)
+}
+
+// GetOrganizations implements domain.OrganizationUsecase.
+func (o *organizationUsecase) GetOrganizations(c context.Context, userID primitive.ObjectID) ([]domain.Organization, error) {
+	return o.organizationRepository.GetOrganizations(c, userID)
+}
+
+// GetMembers implements domain.OrganizationUsecase.
+func (o *organizationUsecase) GetMembers(c context.Context, userID primitive.ObjectID, organizationID string) ([]domain.OrganizationMember, error) {
+	return o.organizationRepository.GetMembers(c, userID, organizationID)
+}
+
+// UpdateMemberRole implements domain.OrganizationUsecase.
+func (o *organizationUsecase) UpdateMemberRole(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string, role string) (domain.OrganizationMember, error) {
+	return o.organizationRepository.UpdateMemberRole(c, userID, organizationID, memberUserID, role)
+}
+
+// RemoveMember implements domain.OrganizationUsecase.
+func (o *organizationUsecase) RemoveMember(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string) error {
+	return o.organizationRepository.RemoveMember(c, userID, organizationID, memberUserID)
+}
+
+// CreateInvitation implements domain.OrganizationUsecase.
+func (o *organizationUsecase) CreateInvitation(c context.Context, userID primitive.ObjectID, organizationID string, request domain.InvitationRequest) (domain.Invitation, error) {
+	return o.organizationRepository.CreateInvitation(c, userID, organizationID, request)
+}
+
+// GetOrganizationInvitations implements domain.OrganizationUsecase.
+func (o *organizationUsecase) GetOrganizationInvitations(c context.Context, userID primitive.ObjectID, organizationID string) ([]domain.Invitation, error) {
+	return o.organizationRepository.GetOrganizationInvitations(c, userID, organizationID)
+}
+
+// RevokeInvitation implements domain.OrganizationUsecase.
+func (o *organizationUsecase) RevokeInvitation(c context.Context, userID primitive.ObjectID, organizationID string, invitationID string) error {
+	return o.organizationRepository.RevokeInvitation(c, userID, organizationID, invitationID)
+}
+
+// GetMyInvitations implements domain.OrganizationUsecase.
+func (o *organizationUsecase) GetMyInvitations(c context.Context, userID primitive.ObjectID) ([]domain.Invitation, error) {
+	return o.organizationRepository.GetMyInvitations(c, userID)
+}
+
+// AcceptInvitation implements domain.OrganizationUsecase.
+func (o *organizationUsecase) AcceptInvitation(c context.Context, userID primitive.ObjectID, invitationID string) (domain.OrganizationMember, error) {
+	return o.organizationRepository.AcceptInvitation(c, userID, invitationID)
+}
+
+// GetMemberRooms implements domain.OrganizationUsecase.
+func (o *organizationUsecase) GetMemberRooms(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string) ([]domain.Room, error) {
+	return o.organizationRepository.GetMemberRooms(c, userID, organizationID, memberUserID)
+}
+
+// GetMemberRoom implements domain.OrganizationUsecase.
+func (o *organizationUsecase) GetMemberRoom(c context.Context, userID primitive.ObjectID, organizationID string, memberUserID string, roomID string) (domain.Room, error) {
+	return o.organizationRepository.GetMemberRoom(c, userID, organizationID, memberUserID, roomID)
+}
+
+func NewOrganizationUsecase(organizationRepository domain.OrganizationRepository, timeout time.Duration) domain.OrganizationUsecase {
+	return &organizationUsecase{
+		organizationRepository: organizationRepository,
+		ContextTimeout:         timeout,
+	}
+}
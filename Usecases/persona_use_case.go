@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+type personaUsecase struct {
+	personaRepository domain.PersonaRepository
+	ContextTimeout    time.Duration
+}
+
+// CreatePersona implements domain.PersonaUsecase.
+func (p *personaUsecase) CreatePersona(c context.Context, persona domain.Persona) (domain.Persona, error) {
+	return p.personaRepository.CreatePersona(c, persona)
+}
+
+// GetPersonas implements domain.PersonaUsecase.
+func (p *personaUsecase) GetPersonas(c context.Context) ([]domain.Persona, error) {
+	return p.personaRepository.GetPersonas(c)
+}
+
+// GetPersona implements domain.PersonaUsecase.
+func (p *personaUsecase) GetPersona(c context.Context, key string) (domain.Persona, error) {
+	return p.personaRepository.GetPersona(c, key)
+}
+
+// UpdatePersona implements domain.PersonaUsecase.
+func (p *personaUsecase) UpdatePersona(c context.Context, key string, persona domain.Persona) (domain.Persona, error) {
+	return p.personaRepository.UpdatePersona(c, key, persona)
+}
+
+// DeletePersona implements domain.PersonaUsecase.
+func (p *personaUsecase) DeletePersona(c context.Context, key string) error {
+	return p.personaRepository.DeletePersona(c, key)
+}
+
+func NewPersonaUsecase(personaRepository domain.PersonaRepository, timeout time.Duration) domain.PersonaUsecase {
+	return &personaUsecase{
+		personaRepository: personaRepository,
+		ContextTimeout:    timeout,
+	}
+}
@@ -0,0 +1,26 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type progressUsecase struct {
+	progressRepository domain.ProgressRepository
+	ContextTimeout     time.Duration
+}
+
+// GetProgress implements domain.ProgressUsecase.
+func (p *progressUsecase) GetProgress(c context.Context, userID primitive.ObjectID, query domain.ProgressQuery) (domain.ProgressReport, error) {
+	return p.progressRepository.GetProgress(c, userID, query)
+}
+
+func NewProgressUsecase(progressRepository domain.ProgressRepository, timeout time.Duration) domain.ProgressUsecase {
+	return &progressUsecase{
+		progressRepository: progressRepository,
+		ContextTimeout:     timeout,
+	}
+}
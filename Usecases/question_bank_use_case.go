@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+)
+
+type questionBankUsecase struct {
+	questionBankRepository domain.QuestionBankRepository
+	ContextTimeout         time.Duration
+}
+
+// CreateQuestion implements domain.QuestionBankUsecase.
+func (q *questionBankUsecase) CreateQuestion(c context.Context, question domain.Question) (domain.Question, error) {
+	return q.questionBankRepository.CreateQuestion(c, question)
+}
+
+// GetQuestion implements domain.QuestionBankUsecase.
+func (q *questionBankUsecase) GetQuestion(c context.Context, questionID string) (domain.Question, error) {
+	return q.questionBankRepository.GetQuestion(c, questionID)
+}
+
+// GetQuestions implements domain.QuestionBankUsecase.
+func (q *questionBankUsecase) GetQuestions(c context.Context, filter domain.QuestionFilter) ([]domain.Question, error) {
+	return q.questionBankRepository.GetQuestions(c, filter)
+}
+
+// UpdateQuestion implements domain.QuestionBankUsecase.
+func (q *questionBankUsecase) UpdateQuestion(c context.Context, questionID string, question domain.Question) (domain.Question, error) {
+	return q.questionBankRepository.UpdateQuestion(c, questionID, question)
+}
+
+// DeleteQuestion implements domain.QuestionBankUsecase.
+func (q *questionBankUsecase) DeleteQuestion(c context.Context, questionID string) error {
+	return q.questionBankRepository.DeleteQuestion(c, questionID)
+}
+
+// ImportQuestions implements domain.QuestionBankUsecase.
+func (q *questionBankUsecase) ImportQuestions(c context.Context, questions []domain.Question) (int, error) {
+	return q.questionBankRepository.ImportQuestions(c, questions)
+}
+
+func NewQuestionBankUsecase(questionBankRepository domain.QuestionBankRepository, timeout time.Duration) domain.QuestionBankUsecase {
+	return &questionBankUsecase{
+		questionBankRepository: questionBankRepository,
+		ContextTimeout:         timeout,
+	}
+}
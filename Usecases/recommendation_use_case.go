@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type recommendationUsecase struct {
+	recommendationRepository domain.RecommendationRepository
+	ContextTimeout           time.Duration
+}
+
+// GetRecommendations implements domain.RecommendationUsecase.
+func (r *recommendationUsecase) GetRecommendations(c context.Context, userID primitive.ObjectID, limit int) ([]domain.Recommendation, error) {
+	return r.recommendationRepository.GetRecommendations(c, userID, limit)
+}
+
+// CreatePracticePlan implements domain.RecommendationUsecase.
+func (r *recommendationUsecase) CreatePracticePlan(c context.Context, userID primitive.ObjectID, request domain.PracticePlanRequest) (domain.PracticePlan, error) {
+	return r.recommendationRepository.CreatePracticePlan(c, userID, request)
+}
+
+// GetPracticePlan implements domain.RecommendationUsecase.
+func (r *recommendationUsecase) GetPracticePlan(c context.Context, userID primitive.ObjectID) (domain.PracticePlan, error) {
+	return r.recommendationRepository.GetPracticePlan(c, userID)
+}
+
+// StartRecommendation implements domain.RecommendationUsecase.
+func (r *recommendationUsecase) StartRecommendation(c context.Context, userID primitive.ObjectID, recommendation domain.Recommendation) (domain.Room, error) {
+	return r.recommendationRepository.StartRecommendation(c, userID, recommendation)
+}
+
+func NewRecommendationUsecase(recommendationRepository domain.RecommendationRepository, timeout time.Duration) domain.RecommendationUsecase {
+	return &recommendationUsecase{
+		recommendationRepository: recommendationRepository,
+		ContextTimeout:           timeout,
+	}
+}
@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type resumeUsecase struct {
+	resumeRepository domain.ResumeRepository
+	ContextTimeout   time.Duration
+}
+
+// UploadResume implements domain.ResumeUsecase.
+func (r *resumeUsecase) UploadResume(c context.Context, userID primitive.ObjectID, upload domain.ResumeUpload) (domain.CandidateProfile, error) {
+	return r.resumeRepository.UploadResume(c, userID, upload)
+}
+
+// GetCandidateProfile implements domain.ResumeUsecase.
+func (r *resumeUsecase) GetCandidateProfile(c context.Context, userID primitive.ObjectID) (domain.CandidateProfile, error) {
+	return r.resumeRepository.GetCandidateProfile(c, userID)
+}
+
+func NewResumeUsecase(resumeRepository domain.ResumeRepository, timeout time.Duration) domain.ResumeUsecase {
+	return &resumeUsecase{
+		resumeRepository: resumeRepository,
+		ContextTimeout:   timeout,
+	}
+}
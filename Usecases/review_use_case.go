@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type reviewUsecase struct {
+	reviewRepository domain.ReviewRepository
+	ContextTimeout   time.Duration
+}
+
+// GetReviewItems implements domain.ReviewUsecase.
+func (r *reviewUsecase) GetReviewItems(c context.Context, userID primitive.ObjectID, dueOnly bool) ([]domain.ReviewItem, error) {
+	return r.reviewRepository.GetReviewItems(c, userID, dueOnly)
+}
+
+// CreateReviewSession implements domain.ReviewUsecase.
+func (r *reviewUsecase) CreateReviewSession(c context.Context, userID primitive.ObjectID, count int) (domain.Room, error) {
+	return r.reviewRepository.CreateReviewSession(c, userID, count)
+}
+
+func NewReviewUsecase(reviewRepository domain.ReviewRepository, timeout time.Duration) domain.ReviewUsecase {
+	return &reviewUsecase{
+		reviewRepository: reviewRepository,
+		ContextTimeout:   timeout,
+	}
+}
@@ -14,23 +14,23 @@ type roomUsecase struct {
 }
 
 // AddMessageToRoom implements domain.RoomUsecase.
-func (r *roomUsecase) AddMessageToRoom(c context.Context, roomID string, message domain.Message) (domain.Room, error) {
-	return r.roomRepository.AddMessageToRoom(c, roomID, message)
+func (r *roomUsecase) AddMessageToRoom(c context.Context, userID primitive.ObjectID, roomID string, message domain.Message) (domain.Room, error) {
+	return r.roomRepository.AddMessageToRoom(c, userID, roomID, message)
 }
 
 // CreateRoom implements domain.RoomUsecase.
-func (r *roomUsecase) CreateRoom(c context.Context, room domain.Room) (string, error) {
+func (r *roomUsecase) CreateRoom(c context.Context, room domain.Room) (domain.Room, error) {
 	return r.roomRepository.CreateRoom(c, room)
 }
 
 // DeleteRoom implements domain.RoomUsecase.
-func (r *roomUsecase) DeleteRoom(c context.Context, roomID string) error {
-	return r.roomRepository.DeleteRoom(c, roomID)
+func (r *roomUsecase) DeleteRoom(c context.Context, userID primitive.ObjectID, roomID string) error {
+	return r.roomRepository.DeleteRoom(c, userID, roomID)
 }
 
-// GetRoom implements domain.RoomUsecase.
-func (r *roomUsecase) GetRoom(c context.Context, roomID string) (domain.Room, error) {
-	return r.roomRepository.GetRoom(c, roomID)
+// GetUserRoom implements domain.RoomUsecase.
+func (r *roomUsecase) GetUserRoom(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, error) {
+	return r.roomRepository.GetUserRoom(c, userID, roomID)
 }
 
 // GetRoomsWithUserID implements domain.RoomUsecase.
@@ -39,8 +39,8 @@ func (r *roomUsecase) GetRoomsWithUserID(c context.Context, userID primitive.Obj
 }
 
 // UpdateRoom implements domain.RoomUsecase.
-func (r *roomUsecase) UpdateRoom(c context.Context, roomID string, room domain.Room) (domain.Room, error) {
-	return r.roomRepository.UpdateRoom(c, roomID, room)
+func (r *roomUsecase) UpdateRoom(c context.Context, userID primitive.ObjectID, roomID string, request domain.UpdateRoomRequest) (domain.Room, error) {
+	return r.roomRepository.UpdateRoom(c, userID, roomID, request)
 }
 
 // CompletedRoom implements domain.RoomUsecase.
@@ -48,6 +48,46 @@ func (r *roomUsecase) CompletedRoom(c context.Context, userID primitive.ObjectID
 	return r.roomRepository.CompletedRoom(c, userID, roomID)
 }	
 
+// RegenerateLastMessage implements domain.RoomUsecase.
+func (r *roomUsecase) RegenerateLastMessage(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, error) {
+	return r.roomRepository.RegenerateLastMessage(c, userID, roomID)
+}
+
+// EditLastAnswer implements domain.RoomUsecase.
+func (r *roomUsecase) EditLastAnswer(c context.Context, userID primitive.ObjectID, roomID string, text string) (domain.Room, error) {
+	return r.roomRepository.EditLastAnswer(c, userID, roomID, text)
+}
+
+// RetractLastAnswer implements domain.RoomUsecase.
+func (r *roomUsecase) RetractLastAnswer(c context.Context, userID primitive.ObjectID, roomID string) (domain.Room, error) {
+	return r.roomRepository.RetractLastAnswer(c, userID, roomID)
+}
+
+// RequestAssistance implements domain.RoomUsecase.
+func (r *roomUsecase) RequestAssistance(c context.Context, userID primitive.ObjectID, roomID string, assistanceType string) (domain.Room, error) {
+	return r.roomRepository.RequestAssistance(c, userID, roomID, assistanceType)
+}
+
+// ReevaluateRoom implements domain.RoomUsecase.
+func (r *roomUsecase) ReevaluateRoom(c context.Context, userID primitive.ObjectID, roomID string, model string) (domain.FeedbackRun, error) {
+	return r.roomRepository.ReevaluateRoom(c, userID, roomID, model)
+}
+
+// GetFeedbackRuns implements domain.RoomUsecase.
+func (r *roomUsecase) GetFeedbackRuns(c context.Context, userID primitive.ObjectID, roomID string) ([]domain.FeedbackRun, error) {
+	return r.roomRepository.GetFeedbackRuns(c, userID, roomID)
+}
+
+// GetFeedbackRun implements domain.RoomUsecase.
+func (r *roomUsecase) GetFeedbackRun(c context.Context, userID primitive.ObjectID, roomID string, runID string) (domain.FeedbackRun, error) {
+	return r.roomRepository.GetFeedbackRun(c, userID, roomID, runID)
+}
+
+// SetCanonicalRun implements domain.RoomUsecase.
+func (r *roomUsecase) SetCanonicalRun(c context.Context, userID primitive.ObjectID, roomID string, runID string) (domain.Room, error) {
+	return r.roomRepository.SetCanonicalRun(c, userID, roomID, runID)
+}
+
 func NewRoomUsecase(roomRepository domain.RoomRepository, timeout time.Duration) domain.RoomUsecase {
 	return &roomUsecase{
 		roomRepository: roomRepository,
@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type shareLinkUsecase struct {
+	shareLinkRepository domain.ShareLinkRepository
+	ContextTimeout      time.Duration
+}
+
+// CreateShareLink implements domain.ShareLinkUsecase.
+func (s *shareLinkUsecase) CreateShareLink(c context.Context, userID primitive.ObjectID, roomID string, request domain.ShareLinkRequest) (domain.ShareLink, error) {
+	return s.shareLinkRepository.CreateShareLink(c, userID, roomID, request)
+}
+
+// GetShareLinks implements domain.ShareLinkUsecase.
+func (s *shareLinkUsecase) GetShareLinks(c context.Context, userID primitive.ObjectID, roomID string) ([]domain.ShareLink, error) {
+	return s.shareLinkRepository.GetShareLinks(c, userID, roomID)
+}
+
+// RevokeShareLink implements domain.ShareLinkUsecase.
+func (s *shareLinkUsecase) RevokeShareLink(c context.Context, userID primitive.ObjectID, roomID string, linkID string) error {
+	return s.shareLinkRepository.RevokeShareLink(c, userID, roomID, linkID)
+}
+
+// GetSharedReport implements domain.ShareLinkUsecase.
+func (s *shareLinkUsecase) GetSharedReport(c context.Context, token string) (domain.Report, error) {
+	return s.shareLinkRepository.GetSharedReport(c, token)
+}
+
+func NewShareLinkUsecase(shareLinkRepository domain.ShareLinkRepository, timeout time.Duration) domain.ShareLinkUsecase {
+	return &shareLinkUsecase{
+		shareLinkRepository: shareLinkRepository,
+		ContextTimeout:      timeout,
+	}
+}
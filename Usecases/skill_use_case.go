@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type skillUsecase struct {
+	skillRepository domain.SkillRepository
+	ContextTimeout  time.Duration
+}
+
+// CreateSkill implements domain.SkillUsecase.
+func (s *skillUsecase) CreateSkill(c context.Context, skill domain.Skill) (domain.Skill, error) {
+	return s.skillRepository.CreateSkill(c, skill)
+}
+
+// GetSkills implements domain.SkillUsecase.
+func (s *skillUsecase) GetSkills(c context.Context) ([]domain.Skill, error) {
+	return s.skillRepository.GetSkills(c)
+}
+
+// GetSkill implements domain.SkillUsecase.
+func (s *skillUsecase) GetSkill(c context.Context, key string) (domain.Skill, error) {
+	return s.skillRepository.GetSkill(c, key)
+}
+
+// UpdateSkill implements domain.SkillUsecase.
+func (s *skillUsecase) UpdateSkill(c context.Context, key string, skill domain.Skill) (domain.Skill, error) {
+	return s.skillRepository.UpdateSkill(c, key, skill)
+}
+
+// DeleteSkill implements domain.SkillUsecase.
+func (s *skillUsecase) DeleteSkill(c context.Context, key string) error {
+	return s.skillRepository.DeleteSkill(c, key)
+}
+
+// RetagAll implements domain.SkillUsecase.
+func (s *skillUsecase) RetagAll(c context.Context) (domain.SkillRetagResult, error) {
+	return s.skillRepository.RetagAll(c)
+}
+
+// GetCompetencyMap implements domain.SkillUsecase.
+func (s *skillUsecase) GetCompetencyMap(c context.Context, userID primitive.ObjectID) ([]domain.SkillCompetency, error) {
+	return s.skillRepository.GetCompetencyMap(c, userID)
+}
+
+func NewSkillUsecase(skillRepository domain.SkillRepository, timeout time.Duration) domain.SkillUsecase {
+	return &skillUsecase{
+		skillRepository: skillRepository,
+		ContextTimeout:  timeout,
+	}
+}
@@ -0,0 +1,31 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	domain "github.com/chachidani/interview-coach-backend/Domain"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type voiceAnswerUsecase struct {
+	voiceAnswerRepository domain.VoiceAnswerRepository
+	ContextTimeout        time.Duration
+}
+
+// SubmitVoiceAnswer implements domain.VoiceAnswerUsecase.
+func (v *voiceAnswerUsecase) SubmitVoiceAnswer(c context.Context, userID primitive.ObjectID, roomID string, upload domain.AudioUpload) (domain.VoiceAnswer, error) {
+	return v.voiceAnswerRepository.SubmitVoiceAnswer(c, userID, roomID, upload)
+}
+
+// GetVoiceAnswer implements domain.VoiceAnswerUsecase.
+func (v *voiceAnswerUsecase) GetVoiceAnswer(c context.Context, userID primitive.ObjectID, voiceAnswerID string) (domain.VoiceAnswer, error) {
+	return v.voiceAnswerRepository.GetVoiceAnswer(c, userID, voiceAnswerID)
+}
+
+func NewVoiceAnswerUsecase(voiceAnswerRepository domain.VoiceAnswerRepository, timeout time.Duration) domain.VoiceAnswerUsecase {
+	return &voiceAnswerUsecase{
+		voiceAnswerRepository: voiceAnswerRepository,
+		ContextTimeout:        timeout,
+	}
+}
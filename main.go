@@ -8,6 +8,7 @@ import (
 
 	bootstrap "github.com/chachidani/interview-coach-backend/Bootstrap"
 	"github.com/chachidani/interview-coach-backend/Delivery/router"
+	repository "github.com/chachidani/interview-coach-backend/Repository"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -52,6 +53,11 @@ func main() {
 	}
 	db := client.Database(dbName)
 
+	// Without the indexes duplicates can slip through, but the app still works
+	if err := repository.EnsureIndexes(context.TODO(), *db); err != nil {
+		log.Printf("Failed to create indexes: %v", err)
+	}
+
 	// Initialize Gin router
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.Default()